@@ -25,6 +25,8 @@ import (
 
 	ocpconfigv1 "github.com/openshift/api/config/v1"
 	secv1 "github.com/openshift/api/security/v1"
+	uberzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -38,7 +40,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"kubevirt.io/hostpath-provisioner-operator/pkg/apis"
-	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1"
+	hppv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 	"kubevirt.io/hostpath-provisioner-operator/pkg/controller"
 	"kubevirt.io/hostpath-provisioner-operator/pkg/controller/hostpathprovisioner"
 	"kubevirt.io/hostpath-provisioner-operator/pkg/util/cryptopolicy"
@@ -56,6 +59,17 @@ func main() {
 	opts.BindFlags(flag.CommandLine)
 
 	flag.Parse()
+	// Keep the level of the logger adjustable, so the log verbosity of the CR applies without a restart.
+	if opts.Level == nil {
+		level := zapcore.InfoLevel
+		if opts.Development {
+			level = zapcore.DebugLevel
+		}
+		opts.Level = uberzap.NewAtomicLevelAt(level)
+	}
+	if level, ok := opts.Level.(uberzap.AtomicLevel); ok {
+		hostpathprovisioner.SetOperatorLogLevel(level)
+	}
 	// The logger instantiated here can be changed to any logger
 	// implementing the logr.Logger interface. This logger will
 	// be propagated through the whole operator, generating
@@ -139,10 +153,14 @@ func main() {
 		log.Error(err, "")
 		os.Exit(1)
 	}
-	if err := (&hppv1.HostPathProvisioner{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&hppv1beta1.HostPathProvisioner{}).SetupWebhookWithManager(mgr); err != nil {
 		log.Error(err, "unable to create webhook", "webhook", "Hostpathprovisioner")
 		os.Exit(1)
 	}
+	if err := (&hppv1.HostPathProvisioner{}).SetupWebhookWithManager(mgr); err != nil {
+		log.Error(err, "unable to create conversion webhook", "webhook", "Hostpathprovisioner")
+		os.Exit(1)
+	}
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		log.Error(err, "unable to set up health check")
 		os.Exit(1)
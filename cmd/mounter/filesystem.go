@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultFsType = "xfs"
+)
+
+var (
+	createFilesystem = func(fsType, source string, options []string) ([]byte, error) {
+		args := append(append([]string{}, options...), source)
+		return exec.Command(fmt.Sprintf("/usr/sbin/mkfs.%s", fsType), args...).CombinedOutput()
+	}
+)
+
+// stringSliceFlag is a command line flag that can be repeated, each value is appended to the slice.
+type stringSliceFlag []string
+
+func (s *stringSliceFlag) String() string {
+	return strings.Join(*s, " ")
+}
+
+func (s *stringSliceFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// filesystemConfig is how the device of a block storage pool is formatted and mounted. Devices are formatted with xfs
+// if the filesystem type is not set, and the filesystem type of formatted devices is not checked.
+type filesystemConfig struct {
+	fsType       string
+	mkfsOptions  []string
+	mountOptions []string
+}
+
+func newFilesystemConfig(fsType string, mkfsOptions []string, mountOptions string) *filesystemConfig {
+	res := &filesystemConfig{
+		fsType:      fsType,
+		mkfsOptions: mkfsOptions,
+	}
+	for _, option := range strings.Split(mountOptions, ",") {
+		if option != "" {
+			res.mountOptions = append(res.mountOptions, option)
+		}
+	}
+	return res
+}
+
+// mkfsType returns the filesystem type new devices are formatted with
+func (f *filesystemConfig) mkfsType() string {
+	if f.fsType == "" {
+		return defaultFsType
+	}
+	return f.fsType
+}
+
+// checkFsType returns an error if the device is formatted with another filesystem than the configured one.
+func (f *filesystemConfig) checkFsType(device, fsType string) error {
+	if f.fsType != "" && fsType != f.fsType {
+		return errors.Errorf("device %s is formatted with %s instead of %s, refusing to mount it", device, fsType, f.fsType)
+	}
+	return nil
+}
+
+// formatIfBlank returns the filesystem type of the device, and creates the filesystem first if the device is blank. A
+// device that has data, but no filesystem the mounter recognizes, is never formatted.
+func (f *filesystemConfig) formatIfBlank(device string) (string, error) {
+	fsType, err := host.FilesystemType(device)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to determine filesystem type on device %s", device)
+	}
+	switch fsType {
+	case "":
+		fsType = f.mkfsType()
+		log.Info("Creating filesystem", "device", device, "fsType", fsType)
+		out, err := createFilesystem(fsType, device, f.mkfsOptions)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to create %s filesystem on device %s: %s", fsType, device, string(out))
+		}
+	case unknownFsType:
+		return "", errors.Errorf("device %s is not blank and has no filesystem the mounter recognizes, refusing to format it", device)
+	default:
+		if isDeviceSignature(fsType) {
+			return "", errors.Errorf("device %s is not blank, found a %s signature, refusing to format it", device, fsType)
+		}
+		if err := f.checkFsType(device, fsType); err != nil {
+			return "", err
+		}
+	}
+	return fsType, nil
+}
+
+// checkMount returns an error if the existing mount of the device has another filesystem type, or lacks one of the
+// configured mount options. The device is not remounted, the mismatch has to be resolved by the administrator.
+func (f *filesystemConfig) checkMount(device string, info *MountInfo) error {
+	if f.fsType != "" && info.Fstype != "" && info.Fstype != f.fsType {
+		return errors.Errorf("device %s is mounted on %s with %s instead of %s", device, info.Target, info.Fstype, f.fsType)
+	}
+	current := make(map[string]struct{})
+	for _, option := range info.GetOptions() {
+		current[option] = struct{}{}
+	}
+	missing := make([]string, 0)
+	for _, option := range f.mountOptions {
+		if _, ok := current[option]; !ok && option != "defaults" {
+			missing = append(missing, option)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("device %s is mounted on %s without the mount options %s, current options are %s", device, info.Target, strings.Join(missing, ","), info.Options)
+	}
+	return nil
+}
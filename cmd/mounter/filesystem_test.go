@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("Filesystem tests", func() {
+	ginkgo.It("should parse repeated mkfs options and comma separated mount options", func() {
+		var mkfsOptions stringSliceFlag
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&mkfsOptions, "mkfsOption", "")
+		gomega.Expect(fs.Parse([]string{"--mkfsOption=-m", "--mkfsOption=crc=1,finobt=1"})).To(gomega.Succeed())
+		config := newFilesystemConfig("xfs", mkfsOptions, "prjquota,noatime")
+		gomega.Expect(config.mkfsOptions).To(gomega.Equal([]string{"-m", "crc=1,finobt=1"}))
+		gomega.Expect(config.mountOptions).To(gomega.Equal([]string{"prjquota", "noatime"}))
+	})
+
+	ginkgo.It("should format new devices with xfs by default", func() {
+		gomega.Expect(newFilesystemConfig("", nil, "").mkfsType()).To(gomega.Equal("xfs"))
+		gomega.Expect(newFilesystemConfig("ext4", nil, "").mkfsType()).To(gomega.Equal("ext4"))
+	})
+
+	ginkgo.It("should report a filesystem type mismatch", func() {
+		gomega.Expect(newFilesystemConfig("ext4", nil, "").checkFsType("/dev/vdb", "xfs")).To(
+			gomega.MatchError("device /dev/vdb is formatted with xfs instead of ext4, refusing to mount it"))
+		gomega.Expect(newFilesystemConfig("xfs", nil, "").checkFsType("/dev/vdb", "xfs")).To(gomega.Succeed())
+		gomega.Expect(newFilesystemConfig("", nil, "").checkFsType("/dev/vdb", "ext4")).To(gomega.Succeed())
+	})
+
+	ginkgo.Context("formatting", func() {
+		var created []string
+
+		ginkgo.BeforeEach(func() {
+			created = nil
+			createFilesystem = func(fsType, source string, options []string) ([]byte, error) {
+				created = append(created, fsType)
+				return nil, nil
+			}
+		})
+
+		ginkgo.It("should format a blank device", func() {
+			fsType, err := newFilesystemConfig("ext4", nil, "").formatIfBlank(writeDeviceImage(1024*1024, map[int64][]byte{}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(fsType).To(gomega.Equal("ext4"))
+			gomega.Expect(created).To(gomega.Equal([]string{"ext4"}))
+		})
+
+		ginkgo.It("should not format a device with a filesystem", func() {
+			fsType, err := newFilesystemConfig("", nil, "").formatIfBlank(writeDeviceImage(1024*1024, map[int64][]byte{0: []byte("XFSB")}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(fsType).To(gomega.Equal("xfs"))
+			gomega.Expect(created).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should refuse to format a device with data the mounter does not recognize", func() {
+			device := writeDeviceImage(1024*1024, map[int64][]byte{0x8000: []byte("data")})
+			_, err := newFilesystemConfig("", nil, "").formatIfBlank(device)
+			gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf("device %s is not blank and has no filesystem the mounter recognizes, refusing to format it", device)))
+			gomega.Expect(created).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should refuse to format an LVM member", func() {
+			device := writeDeviceImage(1024*1024, map[int64][]byte{0x200: []byte("LABELONE"), 0x218: []byte("LVM2 001")})
+			_, err := newFilesystemConfig("", nil, "").formatIfBlank(device)
+			gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf("device %s is not blank, found a LVM2_member signature, refusing to format it", device)))
+			gomega.Expect(created).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should refuse to format a RAID member with its superblock at the end of the device", func() {
+			device := writeDeviceImage(2*blankProbeSize, map[int64][]byte{2*blankProbeSize - 0x10000: {0xfc, 0x4e, 0x2b, 0xa9}})
+			_, err := newFilesystemConfig("", nil, "").formatIfBlank(device)
+			gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf("device %s is not blank, found a linux_raid_member signature, refusing to format it", device)))
+			gomega.Expect(created).To(gomega.BeEmpty())
+		})
+	})
+
+	ginkgo.It("should report missing mount options", func() {
+		info := &MountInfo{
+			Target:  "/var/hpvolumes",
+			Source:  "/dev/vdb",
+			Fstype:  "xfs",
+			Options: "rw,relatime,attr2,inode64,logbufs=8,logbsize=32k,prjquota",
+		}
+		gomega.Expect(newFilesystemConfig("xfs", nil, "prjquota,defaults").checkMount("/dev/vdb", info)).To(gomega.Succeed())
+		gomega.Expect(newFilesystemConfig("xfs", nil, "prjquota,noatime,discard").checkMount("/dev/vdb", info)).To(
+			gomega.MatchError("device /dev/vdb is mounted on /var/hpvolumes without the mount options noatime,discard, current options are rw,relatime,attr2,inode64,logbufs=8,logbsize=32k,prjquota"))
+		gomega.Expect(newFilesystemConfig("ext4", nil, "").checkMount("/dev/vdb", info)).To(
+			gomega.MatchError("device /dev/vdb is mounted on /var/hpvolumes with xfs instead of ext4"))
+	})
+})
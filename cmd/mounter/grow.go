@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os/exec"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	luksResizeCommand = func(name string, key []byte) ([]byte, error) {
+		cmd := exec.Command("/usr/sbin/cryptsetup", "resize", "--key-file", "-", name)
+		cmd.Stdin = bytes.NewReader(key)
+		return cmd.CombinedOutput()
+	}
+
+	// xfs is grown through the mount point, ext4 through the device.
+	xfsGrowCommand = func(target string) ([]byte, error) {
+		return exec.Command("/usr/sbin/xfs_growfs", target).CombinedOutput()
+	}
+
+	ext4GrowCommand = func(device string) ([]byte, error) {
+		return exec.Command("/usr/sbin/resize2fs", device).CombinedOutput()
+	}
+)
+
+// filesystemGrower grows the mounted filesystem of a block storage pool online when the device grows, after the pool
+// PVC is expanded.
+type filesystemGrower struct {
+	// deviceSize is the size of the device the filesystem was last grown to, zero if it hasn't been grown yet. The
+	// filesystem is grown once at startup, in case the device grew while the mounter was not running.
+	deviceSize int64
+}
+
+// growIfNeeded grows the filesystem on the device mounted on the target path if the size of the raw device changed.
+// The LUKS device of an encrypted pool is resized before the filesystem. It has to be called in the root of the host.
+func (g *filesystemGrower) growIfNeeded(rawDevice, device, fsType, targetPath string, luks *luksConfig) error {
+	size, err := host.DeviceSize(rawDevice)
+	if err != nil {
+		return errors.Wrapf(err, "unable to determine the size of device %s", rawDevice)
+	}
+	if size == g.deviceSize {
+		return nil
+	}
+	log.Info("Growing filesystem to the size of the device", "device", rawDevice, "size", size, "previous size", g.deviceSize)
+	if luks != nil {
+		if out, err := luksResizeCommand(luks.name, luks.key); err != nil {
+			return errors.Wrapf(err, "unable to resize LUKS device %s: %s", luks.name, string(out))
+		}
+	}
+	var out []byte
+	switch fsType {
+	case "xfs":
+		out, err = xfsGrowCommand(targetPath)
+	case "ext4":
+		out, err = ext4GrowCommand(device)
+	default:
+		log.Info("Unable to grow filesystem online, unsupported filesystem type", "device", device, "fsType", fsType)
+		g.deviceSize = size
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unable to grow %s filesystem on device %s: %s", fsType, device, string(out))
+	}
+	log.V(1).Info("Output", "out", string(out))
+	g.deviceSize = size
+	return nil
+}
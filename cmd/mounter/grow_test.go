@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"fmt"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+)
+
+// deviceSizeHostInfo reports a fixed size for all devices
+type deviceSizeHostInfo struct {
+	hostInfo
+	size int64
+}
+
+func (h *deviceSizeHostInfo) DeviceSize(device string) (int64, error) {
+	return h.size, nil
+}
+
+var _ = ginkgo.Describe("Filesystem grow tests", func() {
+	var (
+		deviceSize *deviceSizeHostInfo
+		grown      []string
+		resized    []string
+		growErr    error
+	)
+
+	ginkgo.BeforeEach(func() {
+		deviceSize = &deviceSizeHostInfo{
+			hostInfo: newSysHostInfo(fixtureRoot),
+			size:     1073741824,
+		}
+		host = deviceSize
+		grown = nil
+		resized = nil
+		growErr = nil
+		xfsGrowCommand = func(target string) ([]byte, error) {
+			grown = append(grown, fmt.Sprintf("xfs %s", target))
+			return nil, growErr
+		}
+		ext4GrowCommand = func(device string) ([]byte, error) {
+			grown = append(grown, fmt.Sprintf("ext4 %s", device))
+			return nil, growErr
+		}
+		luksResizeCommand = func(name string, key []byte) ([]byte, error) {
+			resized = append(resized, name)
+			return nil, nil
+		}
+	})
+
+	ginkgo.AfterEach(func() {
+		host = newSysHostInfo("/")
+	})
+
+	ginkgo.It("should grow the filesystem at startup and when the device grows", func() {
+		grower := &filesystemGrower{}
+		gomega.Expect(grower.growIfNeeded("/dev/vdb", "/dev/vdb", "xfs", "/var/hpvolumes", nil)).To(gomega.Succeed())
+		gomega.Expect(grown).To(gomega.Equal([]string{"xfs /var/hpvolumes"}))
+
+		ginkgo.By("Not growing the filesystem if the device size didn't change")
+		gomega.Expect(grower.growIfNeeded("/dev/vdb", "/dev/vdb", "xfs", "/var/hpvolumes", nil)).To(gomega.Succeed())
+		gomega.Expect(grown).To(gomega.HaveLen(1))
+
+		ginkgo.By("Growing the filesystem once the device grew")
+		deviceSize.size = 2147483648
+		gomega.Expect(grower.growIfNeeded("/dev/vdb", "/dev/vdb", "xfs", "/var/hpvolumes", nil)).To(gomega.Succeed())
+		gomega.Expect(grown).To(gomega.HaveLen(2))
+		gomega.Expect(grower.deviceSize).To(gomega.Equal(int64(2147483648)))
+	})
+
+	ginkgo.It("should resize the LUKS device before growing an ext4 filesystem", func() {
+		luks := &luksConfig{
+			name: "hpp-pool-local",
+			key:  []byte("secret"),
+		}
+		grower := &filesystemGrower{}
+		gomega.Expect(grower.growIfNeeded("/dev/vdb", "/dev/mapper/hpp-pool-local", "ext4", "/var/hpvolumes", luks)).To(gomega.Succeed())
+		gomega.Expect(resized).To(gomega.Equal([]string{"hpp-pool-local"}))
+		gomega.Expect(grown).To(gomega.Equal([]string{"ext4 /dev/mapper/hpp-pool-local"}))
+	})
+
+	ginkgo.It("should retry growing the filesystem after a failure", func() {
+		growErr = fmt.Errorf("exit status 1")
+		grower := &filesystemGrower{}
+		gomega.Expect(grower.growIfNeeded("/dev/vdb", "/dev/vdb", "xfs", "/var/hpvolumes", nil)).To(
+			gomega.MatchError(gomega.ContainSubstring("unable to grow xfs filesystem on device /dev/vdb")))
+		gomega.Expect(grower.deviceSize).To(gomega.BeZero())
+		growErr = nil
+		gomega.Expect(grower.growIfNeeded("/dev/vdb", "/dev/vdb", "xfs", "/var/hpvolumes", nil)).To(gomega.Succeed())
+		gomega.Expect(grown).To(gomega.HaveLen(2))
+	})
+})
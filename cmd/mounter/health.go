@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/monitoring/metrics"
+)
+
+const (
+	notCheckedReason = "the storage pool mount has not been checked yet"
+)
+
+// poolStatus is the state of the storage pool mount of this mounter
+var poolStatus = newPoolMountStatus(time.Now)
+
+// poolMountStatus is the state of the storage pool mount on the host as last verified by the mount monitor. The health
+// and readiness endpoints report it instead of reading the mounts themselves, the mounter changes its root to the host
+// while it checks the mount.
+type poolMountStatus struct {
+	mu  sync.Mutex
+	now func() time.Time
+	// seen is true once the pool was mounted on the host by this mounter, or found mounted.
+	seen bool
+	// mounted is true if the target was found mounted from the expected source in the last check.
+	mounted bool
+	// reason the pool is not mounted
+	reason       string
+	missingSince time.Time
+	lastSync     time.Time
+}
+
+func newPoolMountStatus(now func() time.Time) *poolMountStatus {
+	started := now()
+	return &poolMountStatus{
+		now:          now,
+		reason:       notCheckedReason,
+		missingSince: started,
+		lastSync:     started,
+	}
+}
+
+// mountFound records the target was found mounted from the expected source
+func (s *poolMountStatus) mountFound() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen = true
+	s.mounted = true
+	s.reason = ""
+}
+
+// mountMissing records the target is not mounted from the expected source
+func (s *poolMountStatus) mountMissing(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mounted {
+		s.missingSince = s.now()
+	}
+	s.mounted = false
+	s.reason = reason
+}
+
+// mountPerformed records the mounter mounted the pool on the host. The mount is only verified in the next check, which
+// the mount triggers. A mount performed after the pool was already mounted re-establishes a lost mount, it is counted
+// in the remounts metric.
+func (s *poolMountStatus) mountPerformed() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seen {
+		log.Info("Re-established the mount of the storage pool on the host")
+		metrics.IncMounterRemounts()
+	}
+	s.seen = true
+}
+
+// synced records the mount monitor completed a check
+func (s *poolMountStatus) synced() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastSync = s.now()
+}
+
+// ready returns an error if the target is not mounted from the expected source
+func (s *poolMountStatus) ready() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.mounted {
+		return errors.New(s.reason)
+	}
+	return nil
+}
+
+// healthy returns an error if the mount monitor didn't check the mount within maxSyncAge, or the pool has not been
+// mounted for longer than the missing grace period.
+func (s *poolMountStatus) healthy(maxSyncAge, missingGracePeriod time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if age := now.Sub(s.lastSync); age > maxSyncAge {
+		return errors.Errorf("the storage pool mount was last checked %s ago", age.Round(time.Second))
+	}
+	if missing := now.Sub(s.missingSince); !s.mounted && missing > missingGracePeriod {
+		return errors.Errorf("the storage pool has not been mounted for %s: %s", missing.Round(time.Second), s.reason)
+	}
+	return nil
+}
+
+// checkHandler responds with 200 if the check passes, and 503 with the error otherwise
+func checkHandler(check func() error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if err := check(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+}
+
+// newHTTPHandler returns the handler of the metrics, health and readiness endpoints. The mounter is healthy while the
+// mount monitor checks the mount at the resync interval, and the pool isn't left unmounted for longer than the interval.
+func newHTTPHandler(status *poolMountStatus, resyncInterval time.Duration) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/healthz", checkHandler(func() error {
+		return status.healthy(3*resyncInterval, resyncInterval)
+	}))
+	mux.Handle("/readyz", checkHandler(status.ready))
+	return mux
+}
+
+// serveHTTP serves the mounter metrics, health and readiness endpoints on the address in the background
+func serveHTTP(addr string, status *poolMountStatus, resyncInterval time.Duration) error {
+	if err := metrics.SetupMounterMetrics(); err != nil {
+		return err
+	}
+	if addr == "" {
+		return nil
+	}
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           newHTTPHandler(status, resyncInterval),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		log.Info("Serving metrics and health endpoints", "address", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(err, "unable to serve metrics and health endpoints")
+		}
+	}()
+	return nil
+}
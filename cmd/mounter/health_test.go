@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/monitoring/metrics"
+)
+
+var _ = ginkgo.Describe("Health tests", func() {
+	var (
+		now    time.Time
+		status *poolMountStatus
+	)
+
+	ginkgo.BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		status = newPoolMountStatus(func() time.Time {
+			return now
+		})
+	})
+
+	get := func(handler http.Handler, path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	ginkgo.It("should only be ready once the mount was verified", func() {
+		handler := newHTTPHandler(status, time.Minute)
+		res := get(handler, "/readyz")
+		gomega.Expect(res.Code).To(gomega.Equal(http.StatusServiceUnavailable))
+		gomega.Expect(res.Body.String()).To(gomega.ContainSubstring(notCheckedReason))
+
+		ginkgo.By("Not being ready right after mounting the pool")
+		status.mountMissing("/var/hpvolumes/csi is not mounted from /dev/vdb")
+		status.mountPerformed()
+		status.synced()
+		gomega.Expect(get(handler, "/readyz").Code).To(gomega.Equal(http.StatusServiceUnavailable))
+
+		ginkgo.By("Being ready once the mount was found")
+		status.mountFound()
+		status.synced()
+		res = get(handler, "/readyz")
+		gomega.Expect(res.Code).To(gomega.Equal(http.StatusOK))
+		gomega.Expect(get(handler, "/healthz").Code).To(gomega.Equal(http.StatusOK))
+
+		ginkgo.By("Not being ready once the mount is lost")
+		status.mountMissing("/var/hpvolumes/csi is not mounted from /dev/vdb")
+		res = get(handler, "/readyz")
+		gomega.Expect(res.Code).To(gomega.Equal(http.StatusServiceUnavailable))
+		gomega.Expect(res.Body.String()).To(gomega.ContainSubstring("/var/hpvolumes/csi is not mounted from /dev/vdb"))
+	})
+
+	ginkgo.It("should be unhealthy if the pool is not mounted within the grace period", func() {
+		gomega.Expect(status.healthy(3*time.Minute, time.Minute)).To(gomega.Succeed())
+		now = now.Add(2 * time.Minute)
+		status.synced()
+		gomega.Expect(status.healthy(3*time.Minute, time.Minute)).To(
+			gomega.MatchError("the storage pool has not been mounted for 2m0s: " + notCheckedReason))
+
+		ginkgo.By("Restarting the grace period when a mounted pool is lost")
+		status.mountFound()
+		now = now.Add(time.Minute)
+		status.mountMissing("not mounted")
+		status.synced()
+		now = now.Add(30 * time.Second)
+		gomega.Expect(status.healthy(3*time.Minute, time.Minute)).To(gomega.Succeed())
+		now = now.Add(time.Minute)
+		gomega.Expect(status.healthy(3*time.Minute, time.Minute)).To(
+			gomega.MatchError("the storage pool has not been mounted for 1m30s: not mounted"))
+	})
+
+	ginkgo.It("should be unhealthy if the mount was not checked recently", func() {
+		status.mountFound()
+		status.synced()
+		now = now.Add(3 * time.Minute)
+		gomega.Expect(status.healthy(3*time.Minute, time.Minute)).To(gomega.Succeed())
+		now = now.Add(time.Second)
+		gomega.Expect(status.healthy(3*time.Minute, time.Minute)).To(
+			gomega.MatchError("the storage pool mount was last checked 3m1s ago"))
+	})
+
+	ginkgo.It("should only count mounts that re-establish a lost mount", func() {
+		gomega.Expect(metrics.SetupMounterMetrics()).To(gomega.Succeed())
+		before, err := metrics.GetMounterRemounts()
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		status.mountPerformed()
+		status.mountFound()
+		gomega.Expect(metrics.GetMounterRemounts()).To(gomega.Equal(before))
+		status.mountMissing("not mounted")
+		status.mountPerformed()
+		gomega.Expect(metrics.GetMounterRemounts()).To(gomega.Equal(before + 1))
+	})
+
+	ginkgo.It("should serve the metrics", func() {
+		gomega.Expect(metrics.SetupMounterMetrics()).To(gomega.Succeed())
+		res := get(newHTTPHandler(status, time.Minute), "/metrics")
+		gomega.Expect(res.Code).To(gomega.Equal(http.StatusOK))
+		gomega.Expect(res.Body.String()).To(gomega.ContainSubstring("kubevirt_hpp_mounter_remounts_total"))
+	})
+})
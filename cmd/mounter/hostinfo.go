@@ -0,0 +1,450 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	mount "github.com/moby/sys/mountinfo"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	sectorSize = 512
+	// unknownFsType is the filesystem type of a device that is not blank, but has no filesystem the mounter recognizes
+	unknownFsType = "unknown"
+	// blankProbeSize is the size of the start of a device that has to be zero for the device to be blank, it covers the
+	// superblocks of the common filesystems the mounter does not recognize
+	blankProbeSize = 1024 * 1024
+)
+
+// The types of the devices that have no filesystem, but a partition table or are LVM or RAID members. They are never
+// formatted.
+const (
+	gptSignature  = "gpt"
+	dosSignature  = "dos"
+	lvmSignature  = "LVM2_member"
+	raidSignature = "linux_raid_member"
+)
+
+// host is how the mounter reads the mounts, block devices and filesystems. The paths are resolved in the current root,
+// which is the root of the host while the mounter is in the chroot.
+var host hostInfo = newSysHostInfo("/")
+
+// hostInfo reads the mounts, block devices and filesystems of the host
+type hostInfo interface {
+	// MountByTarget returns the mount the path is on, the mount point is the path or one of its parents.
+	MountByTarget(path string) (*MountInfo, error)
+	// MountsBySource returns all the mounts of the source
+	MountsBySource(source string) ([]MountInfo, error)
+	// BlockDevice returns the path in /dev of the block device node at the path
+	BlockDevice(path string) (string, error)
+	// DeviceSize returns the size of the block device in bytes
+	DeviceSize(device string) (int64, error)
+	// FilesystemType returns the type of the filesystem or other signature on the device, empty if the device is
+	// blank, or unknownFsType if it has data but no signature the mounter recognizes
+	FilesystemType(device string) (string, error)
+}
+
+// MountInfo is a mount of the host
+type MountInfo struct {
+	// Target is the mount point
+	Target string
+	// Source is the mounted device or other filesystem specific source
+	Source string
+	// SourcePath is the source for mounts of a whole filesystem. For bind mounts it is the path of the mounted
+	// directory on the host.
+	SourcePath string
+	// Fstype is the filesystem type
+	Fstype string
+	// Options are the comma separated mount and superblock options
+	Options string
+}
+
+// GetOptions returns a split list of all the mount options.
+func (m *MountInfo) GetOptions() []string {
+	return strings.Split(m.Options, ",")
+}
+
+// sysHostInfo reads the host information from procfs, sysfs and the devices.
+type sysHostInfo struct {
+	mountInfoPath string
+	sysBlockPath  string
+	devPath       string
+}
+
+func newSysHostInfo(root string) *sysHostInfo {
+	return &sysHostInfo{
+		mountInfoPath: filepath.Join(root, "proc", "self", "mountinfo"),
+		sysBlockPath:  filepath.Join(root, "sys", "block"),
+		devPath:       filepath.Join(root, "dev"),
+	}
+}
+
+func (h *sysHostInfo) readMounts() ([]*mount.Info, error) {
+	f, err := os.Open(h.mountInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return mount.GetMountsFromReader(f, nil)
+}
+
+// MountByTarget returns the mount with the longest mount point that contains the path. If multiple mounts have the
+// same mount point, the last one is visible.
+func (h *sysHostInfo) MountByTarget(path string) (*MountInfo, error) {
+	mounts, err := h.readMounts()
+	if err != nil {
+		return nil, err
+	}
+	target := filepath.Clean(path)
+	if resolved, err := filepath.EvalSymlinks(target); err == nil {
+		target = resolved
+	}
+	var res *mount.Info
+	for _, m := range mounts {
+		if !isPathUnder(target, m.Mountpoint) {
+			continue
+		}
+		if res == nil || len(m.Mountpoint) >= len(res.Mountpoint) {
+			res = m
+		}
+	}
+	if res == nil {
+		return nil, errors.Errorf("no mount found for %s", path)
+	}
+	info := newMountInfo(res, mounts)
+	return &info, nil
+}
+
+// MountsBySource returns the mounts of the source in the order they were mounted
+func (h *sysHostInfo) MountsBySource(source string) ([]MountInfo, error) {
+	mounts, err := h.readMounts()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]MountInfo, 0)
+	for _, m := range mounts {
+		if m.Source == source {
+			res = append(res, newMountInfo(m, mounts))
+		}
+	}
+	return res, nil
+}
+
+// newMountInfo converts a mountinfo entry. The source path of a bind mount is found through another mount of the same
+// filesystem that contains the mounted directory, for instance the /var mount of the ostree deployment on RHCOS.
+func newMountInfo(m *mount.Info, mounts []*mount.Info) MountInfo {
+	res := MountInfo{
+		Target:     m.Mountpoint,
+		Source:     m.Source,
+		SourcePath: m.Source,
+		Fstype:     m.FSType,
+		Options:    m.Options,
+	}
+	if m.VFSOptions != "" {
+		res.Options = fmt.Sprintf("%s,%s", m.Options, m.VFSOptions)
+	}
+	if m.Root == "/" {
+		return res
+	}
+	res.SourcePath = m.Root
+	var parent *mount.Info
+	for _, other := range mounts {
+		if other.ID == m.ID || other.Major != m.Major || other.Minor != m.Minor || len(other.Root) >= len(m.Root) || !isPathUnder(m.Root, other.Root) {
+			continue
+		}
+		if parent == nil || len(other.Root) > len(parent.Root) {
+			parent = other
+		}
+	}
+	if parent != nil {
+		res.SourcePath = filepath.Join(parent.Mountpoint, strings.TrimPrefix(m.Root, parent.Root))
+	}
+	return res
+}
+
+// isPathUnder returns true if the path is the parent path or below it
+func isPathUnder(path, parent string) bool {
+	if parent == "/" || path == parent {
+		return true
+	}
+	return strings.HasPrefix(path, parent+"/")
+}
+
+// BlockDevice looks up the kernel name of the block device node by its device number, so the device can be found on
+// the host when the path is a device node in the container.
+func (h *sysHostInfo) BlockDevice(path string) (string, error) {
+	major, minor, err := deviceNumber(path)
+	if err != nil {
+		return "", err
+	}
+	dir, err := h.sysBlockDir(major, minor)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(h.devPath, filepath.Base(dir)), nil
+}
+
+// DeviceSize reads the size of the block device from sysfs, the size is in 512 byte sectors.
+func (h *sysHostInfo) DeviceSize(device string) (int64, error) {
+	major, minor, err := deviceNumber(device)
+	if err != nil {
+		return 0, err
+	}
+	dir, err := h.sysBlockDir(major, minor)
+	if err != nil {
+		return 0, err
+	}
+	return readDeviceSize(dir)
+}
+
+func readDeviceSize(sysBlockDir string) (int64, error) {
+	content, err := os.ReadFile(filepath.Join(sysBlockDir, "size"))
+	if err != nil {
+		return 0, err
+	}
+	sectors, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to parse the size of %s", sysBlockDir)
+	}
+	return sectors * sectorSize, nil
+}
+
+func deviceNumber(path string) (uint32, uint32, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	if stat.Mode&unix.S_IFMT != unix.S_IFBLK {
+		return 0, 0, errors.Errorf("%s is not a block device", path)
+	}
+	return unix.Major(stat.Rdev), unix.Minor(stat.Rdev), nil
+}
+
+// sysBlockDir returns the sysfs directory of the block device with the device number. Disks are in /sys/block,
+// partitions are in the directory of their disk.
+func (h *sysHostInfo) sysBlockDir(major, minor uint32) (string, error) {
+	devNumber := fmt.Sprintf("%d:%d", major, minor)
+	disks, err := os.ReadDir(h.sysBlockPath)
+	if err != nil {
+		return "", err
+	}
+	for _, disk := range disks {
+		diskDir := filepath.Join(h.sysBlockPath, disk.Name())
+		if readDevNumber(diskDir) == devNumber {
+			return diskDir, nil
+		}
+		partitions, err := os.ReadDir(diskDir)
+		if err != nil {
+			continue
+		}
+		for _, partition := range partitions {
+			if !strings.HasPrefix(partition.Name(), disk.Name()) {
+				continue
+			}
+			partitionDir := filepath.Join(diskDir, partition.Name())
+			if readDevNumber(partitionDir) == devNumber {
+				return partitionDir, nil
+			}
+		}
+	}
+	return "", errors.Errorf("block device %s not found in %s", devNumber, h.sysBlockPath)
+}
+
+func readDevNumber(sysBlockDir string) string {
+	content, err := os.ReadFile(filepath.Join(sysBlockDir, "dev"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
+// superblockMagic is a signature at a fixed offset of the device that identifies a filesystem
+type superblockMagic struct {
+	fsType string
+	offset int64
+	magic  []byte
+}
+
+// superblockMagics are the filesystems the mounter recognizes. Devices with another filesystem, a partition table or any
+// other data are not blank, their type is unknown. The ext filesystems are identified by their features.
+var superblockMagics = []superblockMagic{
+	{fsType: luksFsType, offset: 0, magic: []byte("LUKS\xba\xbe")},
+	{fsType: "xfs", offset: 0, magic: []byte("XFSB")},
+	{fsType: "ext", offset: 0x438, magic: []byte{0x53, 0xef}},
+	{fsType: "btrfs", offset: 0x10040, magic: []byte("_BHRfS_M")},
+	{fsType: "swap", offset: 0xff6, magic: []byte("SWAPSPACE2")},
+}
+
+const (
+	extSuperblockOffset        = 0x400
+	extCompatHasJournal        = 0x4
+	extIncompatExt4Features    = 0x40 | 0x80 | 0x200 // extents, 64bit, flex_bg
+	extROCompatExt4Features    = 0x8 | 0x10 | 0x40   // huge_file, gdt_csum, extra_isize
+	extFeatureCompatOffset     = 0x5c
+	extFeatureIncompatOffset   = 0x60
+	extFeatureROCompatOffset   = 0x64
+	extFeatureCompatFieldsSize = 12
+)
+
+// FilesystemType reads the signatures of the device to find the type of its filesystem. RAID members are checked first,
+// the array data of some metadata versions starts with the filesystem of the array. A device without a known signature
+// is only blank if its start is all zeros, "unrecognized" is never treated as empty.
+func (h *sysHostInfo) FilesystemType(device string) (string, error) {
+	f, err := os.Open(device)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to determine the size of device %s", device)
+	}
+	if fsType := memberSignature(f, size); fsType != "" {
+		return fsType, nil
+	}
+	for _, sb := range superblockMagics {
+		if !hasMagic(f, sb.offset, sb.magic) {
+			continue
+		}
+		if sb.fsType == "ext" {
+			return extFilesystemType(f)
+		}
+		return sb.fsType, nil
+	}
+	if fsType := partitionTableSignature(f, size); fsType != "" {
+		return fsType, nil
+	}
+	buf := make([]byte, blankProbeSize)
+	n, err := f.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return "", errors.Wrapf(err, "unable to read device %s", device)
+	}
+	for _, b := range buf[:n] {
+		if b != 0 {
+			return unknownFsType, nil
+		}
+	}
+	return "", nil
+}
+
+// hasMagic returns true if the device has the magic at the offset, devices smaller than the offset don't have it
+func hasMagic(f *os.File, offset int64, magic []byte) bool {
+	if offset < 0 {
+		return false
+	}
+	buf := make([]byte, len(magic))
+	if _, err := f.ReadAt(buf, offset); err != nil {
+		return false
+	}
+	return bytes.Equal(buf, magic)
+}
+
+var (
+	// raidMagic is the little endian magic of the Linux RAID superblocks
+	raidMagic = []byte{0xfc, 0x4e, 0x2b, 0xa9}
+	lvmLabel  = []byte("LABELONE")
+	lvmType   = []byte("LVM2 001")
+	gptMagic  = []byte("EFI PART")
+	dosMagic  = []byte{0x55, 0xaa}
+)
+
+const (
+	raidV12Offset    = 4 * 1024
+	raidReservedSize = 64 * 1024
+	raidV1EndOffset  = 8 * 1024
+	raidV1Alignment  = 4 * 1024
+	lvmLabelSectors  = 4
+	lvmTypeOffset    = 0x18
+	largeSectorSize  = 4096
+	dosMagicOffset   = 0x1fe
+)
+
+// memberSignature returns the type of the device if it is a RAID or LVM member. The superblocks of the RAID metadata
+// versions 1.1 and 1.2 are at the start of the device, 0.90 and 1.0 at its end. The LVM label is in one of the first
+// sectors.
+func memberSignature(f *os.File, size int64) string {
+	raidOffsets := []int64{
+		0,
+		raidV12Offset,
+		size&^(raidReservedSize-1) - raidReservedSize,
+		(size - raidV1EndOffset) &^ (raidV1Alignment - 1),
+	}
+	for _, offset := range raidOffsets {
+		if hasMagic(f, offset, raidMagic) {
+			return raidSignature
+		}
+	}
+	for sector := int64(0); sector < lvmLabelSectors; sector++ {
+		offset := sector * sectorSize
+		if hasMagic(f, offset, lvmLabel) && hasMagic(f, offset+lvmTypeOffset, lvmType) {
+			return lvmSignature
+		}
+	}
+	return ""
+}
+
+// partitionTableSignature returns the type of the partition table of the device. A GPT is found by its primary header
+// in the second sector, or by its backup header in the last sector, for 512 byte and 4 KiB sectors. GPT devices also
+// have a protective MBR, so the GPT headers are checked first.
+func partitionTableSignature(f *os.File, size int64) string {
+	for _, sector := range []int64{sectorSize, largeSectorSize} {
+		if hasMagic(f, sector, gptMagic) || hasMagic(f, size-sector, gptMagic) {
+			return gptSignature
+		}
+	}
+	if hasMagic(f, dosMagicOffset, dosMagic) {
+		return dosSignature
+	}
+	return ""
+}
+
+// isDeviceSignature returns true if the type of the device is a partition table, or an LVM or RAID member
+func isDeviceSignature(fsType string) bool {
+	switch fsType {
+	case gptSignature, dosSignature, lvmSignature, raidSignature:
+		return true
+	}
+	return false
+}
+
+// extFilesystemType tells ext2, ext3 and ext4 apart by their feature flags
+func extFilesystemType(f *os.File) (string, error) {
+	buf := make([]byte, extFeatureCompatFieldsSize)
+	if _, err := f.ReadAt(buf, extSuperblockOffset+extFeatureCompatOffset); err != nil {
+		return "", errors.Wrapf(err, "unable to read the ext superblock of %s", f.Name())
+	}
+	compat := binary.LittleEndian.Uint32(buf[0:4])
+	incompat := binary.LittleEndian.Uint32(buf[extFeatureIncompatOffset-extFeatureCompatOffset:])
+	roCompat := binary.LittleEndian.Uint32(buf[extFeatureROCompatOffset-extFeatureCompatOffset:])
+	switch {
+	case incompat&extIncompatExt4Features != 0 || roCompat&extROCompatExt4Features != 0:
+		return "ext4", nil
+	case compat&extCompatHasJournal != 0:
+		return "ext3", nil
+	}
+	return "ext2", nil
+}
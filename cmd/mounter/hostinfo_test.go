@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+)
+
+const fixtureRoot = "testdata/host"
+
+// writeDeviceImage writes a device image of the size with the signatures at their offsets
+func writeDeviceImage(size int64, signatures map[int64][]byte) string {
+	path := filepath.Join(ginkgo.GinkgoT().TempDir(), "device.img")
+	f, err := os.Create(path)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	defer f.Close()
+	gomega.Expect(f.Truncate(size)).To(gomega.Succeed())
+	for offset, signature := range signatures {
+		_, err := f.WriteAt(signature, offset)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	}
+	return path
+}
+
+func extFeatures(compat, incompat, roCompat uint32) []byte {
+	res := make([]byte, 12)
+	binary.LittleEndian.PutUint32(res[0:4], compat)
+	binary.LittleEndian.PutUint32(res[4:8], incompat)
+	binary.LittleEndian.PutUint32(res[8:12], roCompat)
+	return res
+}
+
+var _ = ginkgo.Describe("Host info tests", func() {
+	h := newSysHostInfo(fixtureRoot)
+
+	ginkgo.Context("mounts", func() {
+		ginkgo.It("should find the mount of a device", func() {
+			info, err := h.MountByTarget("/var/hpvolumes/block")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(*info).To(gomega.Equal(MountInfo{
+				Target:     "/var/hpvolumes/block",
+				Source:     "/dev/vdb",
+				SourcePath: "/dev/vdb",
+				Fstype:     "xfs",
+				Options:    "rw,noatime,rw,seclabel,attr2,inode64,logbufs=8,logbsize=32k,prjquota",
+			}))
+			gomega.Expect(info.GetOptions()).To(gomega.ContainElements("noatime", "prjquota"))
+		})
+
+		ginkgo.It("should find the mount a path below a mount point is on", func() {
+			info, err := h.MountByTarget("/var/hpvolumes/block/csi")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(info.Target).To(gomega.Equal("/var/hpvolumes/block"))
+			info, err = h.MountByTarget("/var/hpvolumes/blocks")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(info.Target).To(gomega.Equal("/var"))
+		})
+
+		ginkgo.It("should resolve the source path of a bind mount through the ostree var mount", func() {
+			info, err := h.MountByTarget("/var/hpvolumes/fs")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(info.Source).To(gomega.Equal("/dev/sda4"))
+			gomega.Expect(info.SourcePath).To(gomega.Equal("/var/lib/kubelet/pods/pod-1/volumes/kubernetes.io~csi/pvc-2/mount"))
+		})
+
+		ginkgo.It("should use the root of a bind mount without a parent mount as source path", func() {
+			info, err := h.MountByTarget("/var/lib/kubelet/plugins/kubernetes.io/csi/volumeDevices/publish/pvc-1/pod-3")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(info.SourcePath).To(gomega.Equal("/vdb"))
+		})
+
+		ginkgo.It("should return the last mount of a mount point", func() {
+			info, err := h.MountByTarget("/var/hpvolumes/over")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(info.Source).To(gomega.Equal("/dev/mapper/hpp-pool-local"))
+			gomega.Expect(info.Fstype).To(gomega.Equal("ext4"))
+		})
+
+		ginkgo.It("should find the mounts of a source", func() {
+			infos, err := h.MountsBySource("csi-cephfs-node@cluster.cephfs=/volumes/csi/csi-vol-123/abc")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(infos).To(gomega.HaveLen(2))
+			gomega.Expect(filterGlobalMounts(infos)).To(gomega.HaveLen(1))
+			infos, err = h.MountsBySource("/dev/vdc")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(infos).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should fail if the mountinfo cannot be read", func() {
+			_, err := newSysHostInfo("testdata/missing").MountByTarget("/var")
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("block devices", func() {
+		ginkgo.DescribeTable("should find the sysfs directory and size of a device", func(major, minor int, name string, size int64) {
+			dir, err := h.sysBlockDir(uint32(major), uint32(minor))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(filepath.Base(dir)).To(gomega.Equal(name))
+			gomega.Expect(readDeviceSize(dir)).To(gomega.Equal(size))
+		},
+			ginkgo.Entry("disk", 252, 16, "vdb", int64(128849018880)),
+			ginkgo.Entry("partition", 252, 17, "vdb1", int64(1073741824)),
+			ginkgo.Entry("device mapper", 253, 0, "dm-0", int64(128832241664)),
+		)
+
+		ginkgo.It("should fail for an unknown device", func() {
+			_, err := h.sysBlockDir(252, 32)
+			gomega.Expect(err).To(gomega.MatchError("block device 252:32 not found in testdata/host/sys/block"))
+		})
+
+		ginkgo.It("should fail for a path that is not a block device", func() {
+			_, err := h.BlockDevice(filepath.Join(fixtureRoot, "sys", "block", "vdb", "dev"))
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("is not a block device")))
+		})
+	})
+
+	ginkgo.Context("filesystems", func() {
+		ginkgo.DescribeTable("should identify the filesystem by its superblock", func(signatures map[int64][]byte, expected string) {
+			fsType, err := h.FilesystemType(writeDeviceImage(1024*1024, signatures))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(fsType).To(gomega.Equal(expected))
+		},
+			ginkgo.Entry("empty device", map[int64][]byte{}, ""),
+			ginkgo.Entry("xfs", map[int64][]byte{0: []byte("XFSB")}, "xfs"),
+			ginkgo.Entry("LUKS", map[int64][]byte{0: []byte("LUKS\xba\xbe")}, "crypto_LUKS"),
+			ginkgo.Entry("ext4", map[int64][]byte{0x438: {0x53, 0xef}, 0x45c: extFeatures(0x4, 0x40|0x200, 0)}, "ext4"),
+			ginkgo.Entry("ext3", map[int64][]byte{0x438: {0x53, 0xef}, 0x45c: extFeatures(0x4, 0, 0)}, "ext3"),
+			ginkgo.Entry("ext2", map[int64][]byte{0x438: {0x53, 0xef}, 0x45c: extFeatures(0, 0, 0)}, "ext2"),
+			ginkgo.Entry("btrfs", map[int64][]byte{0x10040: []byte("_BHRfS_M")}, "btrfs"),
+			ginkgo.Entry("swap", map[int64][]byte{0xff6: []byte("SWAPSPACE2")}, "swap"),
+			ginkgo.Entry("dos partition table", map[int64][]byte{0x1fe: {0x55, 0xaa}}, "dos"),
+			ginkgo.Entry("gpt", map[int64][]byte{0x1fe: {0x55, 0xaa}, 0x200: []byte("EFI PART")}, "gpt"),
+			ginkgo.Entry("gpt with 4 KiB sectors", map[int64][]byte{0x1fe: {0x55, 0xaa}, 0x1000: []byte("EFI PART")}, "gpt"),
+			ginkgo.Entry("gpt backup header", map[int64][]byte{1024*1024 - 0x200: []byte("EFI PART")}, "gpt"),
+			ginkgo.Entry("LVM member", map[int64][]byte{0x200: []byte("LABELONE"), 0x218: []byte("LVM2 001")}, "LVM2_member"),
+			ginkgo.Entry("LVM label of another type", map[int64][]byte{0x200: []byte("LABELONE")}, unknownFsType),
+			ginkgo.Entry("RAID 1.1 member", map[int64][]byte{0: {0xfc, 0x4e, 0x2b, 0xa9}}, "linux_raid_member"),
+			ginkgo.Entry("RAID 1.2 member", map[int64][]byte{0x1000: {0xfc, 0x4e, 0x2b, 0xa9}}, "linux_raid_member"),
+			ginkgo.Entry("RAID 0.90 member", map[int64][]byte{1024*1024 - 0x10000: {0xfc, 0x4e, 0x2b, 0xa9}}, "linux_raid_member"),
+			ginkgo.Entry("RAID 1.0 member with the filesystem of the array", map[int64][]byte{0: []byte("XFSB"), 1024*1024 - 0x2000: {0xfc, 0x4e, 0x2b, 0xa9}}, "linux_raid_member"),
+			ginkgo.Entry("unrecognized data", map[int64][]byte{0x8000: []byte("data")}, unknownFsType),
+			ginkgo.Entry("data at the end of the probed start", map[int64][]byte{blankProbeSize - 1: {0x1}}, unknownFsType),
+		)
+
+		ginkgo.It("should treat a device that is only zeros after the probed start as empty", func() {
+			fsType, err := h.FilesystemType(writeDeviceImage(2*blankProbeSize, map[int64][]byte{blankProbeSize: []byte("data")}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(fsType).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should treat a device smaller than the superblocks as empty", func() {
+			fsType, err := h.FilesystemType(writeDeviceImage(16, map[int64][]byte{}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(fsType).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should fail if the device cannot be opened", func() {
+			_, err := h.FilesystemType(filepath.Join(fixtureRoot, "dev", "missing"))
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	luksFsType = "crypto_LUKS"
+)
+
+var (
+	luksFormatCommand = func(device string, key []byte) ([]byte, error) {
+		cmd := exec.Command("/usr/sbin/cryptsetup", "luksFormat", "--batch-mode", "--type", "luks2", "--key-file", "-", device)
+		cmd.Stdin = bytes.NewReader(key)
+		return cmd.CombinedOutput()
+	}
+
+	luksOpenCommand = func(device, name string, key []byte) ([]byte, error) {
+		cmd := exec.Command("/usr/sbin/cryptsetup", "open", "--type", "luks", "--key-file", "-", device, name)
+		cmd.Stdin = bytes.NewReader(key)
+		return cmd.CombinedOutput()
+	}
+
+	luksCloseCommand = func(name string, deferred bool) ([]byte, error) {
+		if deferred {
+			return exec.Command("/usr/sbin/cryptsetup", "close", "--deferred", name).CombinedOutput()
+		}
+		return exec.Command("/usr/sbin/cryptsetup", "close", name).CombinedOutput()
+	}
+
+	deviceExists = func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	// zeroDeviceStart zeroes the start of the device, so a newly opened LUKS device is blank. Its data area reads as
+	// random data until it is written.
+	zeroDeviceStart = func(device string) error {
+		f, err := os.OpenFile(device, os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		size, err := f.Seek(0, io.SeekEnd)
+		if err != nil {
+			return err
+		}
+		if _, err := f.WriteAt(make([]byte, min(size, blankProbeSize)), 0); err != nil {
+			return err
+		}
+		return f.Sync()
+	}
+)
+
+// luksConfig is the LUKS device of an encrypted storage pool, the key is not set in unmount mode.
+type luksConfig struct {
+	name string
+	key  []byte
+}
+
+// readLuksConfig returns the LUKS configuration from the command line, or nil if the storage pool is not encrypted.
+func readLuksConfig(name, keyFile string) (*luksConfig, error) {
+	if name == "" {
+		return nil, nil
+	}
+	res := &luksConfig{
+		name: name,
+	}
+	if keyFile == "" {
+		return res, nil
+	}
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read encryption key file %s", keyFile)
+	}
+	if len(key) == 0 {
+		return nil, errors.Errorf("encryption key file %s is empty", keyFile)
+	}
+	res.key = key
+	return res, nil
+}
+
+func luksDevicePath(name string) string {
+	return filepath.Join("/dev/mapper", name)
+}
+
+// openLuksDevice opens the LUKS device on the device and returns the path of the opened device. A blank device is
+// formatted with LUKS first, a device with an unencrypted filesystem or any other signature is never formatted. It has
+// to be called in the root of the host.
+func openLuksDevice(device string, luks *luksConfig) (string, error) {
+	opened := luksDevicePath(luks.name)
+	if deviceExists(opened) {
+		return opened, nil
+	}
+	fsType, err := host.FilesystemType(device)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to determine filesystem type on device %s", device)
+	}
+	formatted := false
+	switch fsType {
+	case luksFsType:
+	case "":
+		log.Info("Formatting device with LUKS", "device", device)
+		if out, err := luksFormatCommand(device, luks.key); err != nil {
+			return "", errors.Wrapf(err, "unable to format device %s with LUKS: %s", device, string(out))
+		}
+		formatted = true
+	case unknownFsType:
+		return "", errors.Errorf("device %s is not blank and has no filesystem the mounter recognizes, refusing to format it with LUKS", device)
+	default:
+		if isDeviceSignature(fsType) {
+			return "", errors.Errorf("device %s is not blank, found a %s signature, refusing to format it with LUKS", device, fsType)
+		}
+		return "", errors.Errorf("device %s contains an unencrypted %s filesystem, refusing to format it with LUKS", device, fsType)
+	}
+	log.Info("Opening LUKS device", "device", device, "name", luks.name)
+	if out, err := luksOpenCommand(device, luks.name, luks.key); err != nil {
+		return "", errors.Wrapf(err, "unable to open LUKS device %s: %s", device, string(out))
+	}
+	if formatted {
+		if err := zeroDeviceStart(opened); err != nil {
+			return "", errors.Wrapf(err, "unable to clear new LUKS device %s", opened)
+		}
+	}
+	return opened, nil
+}
+
+// closeLuksDevice closes the LUKS device of the storage pool on the host, returns true if the device is closed. A
+// deferred close removes the device once it is no longer in use, after a lazy unmount.
+func closeLuksDevice(luks *luksConfig, hostPath string, deferred bool) bool {
+	exit, err := chroot(hostPath)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		err := exit()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	if !deviceExists(luksDevicePath(luks.name)) {
+		return true
+	}
+	log.Info("Closing LUKS device", "name", luks.name, "deferred", deferred)
+	out, err := luksCloseCommand(luks.name, deferred)
+	if err != nil {
+		log.Error(err, "unable to close LUKS device", "name", luks.name)
+		log.Info("Output", "out", string(out))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("LUKS tests", func() {
+	var (
+		formatted []string
+		opened    []string
+		zeroed    []string
+		device    string
+		exists    bool
+	)
+	luks := &luksConfig{
+		name: "hpp-pool-local",
+		key:  []byte("secret"),
+	}
+
+	ginkgo.BeforeEach(func() {
+		formatted = nil
+		opened = nil
+		zeroed = nil
+		device = writeDeviceImage(1024*1024, map[int64][]byte{})
+		exists = false
+		luksFormatCommand = func(device string, key []byte) ([]byte, error) {
+			gomega.Expect(key).To(gomega.Equal(luks.key))
+			formatted = append(formatted, device)
+			return nil, nil
+		}
+		luksOpenCommand = func(device, name string, key []byte) ([]byte, error) {
+			gomega.Expect(key).To(gomega.Equal(luks.key))
+			opened = append(opened, name)
+			return nil, nil
+		}
+		deviceExists = func(path string) bool {
+			return exists
+		}
+		zeroDeviceStart = func(device string) error {
+			zeroed = append(zeroed, device)
+			return nil
+		}
+	})
+
+	ginkgo.It("should format and open a device without a filesystem", func() {
+		mapped, err := openLuksDevice(device, luks)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(mapped).To(gomega.Equal("/dev/mapper/hpp-pool-local"))
+		gomega.Expect(formatted).To(gomega.Equal([]string{device}))
+		gomega.Expect(opened).To(gomega.Equal([]string{"hpp-pool-local"}))
+		gomega.Expect(zeroed).To(gomega.Equal([]string{mapped}))
+	})
+
+	ginkgo.It("should open a LUKS device without formatting it", func() {
+		device = writeDeviceImage(1024*1024, map[int64][]byte{0: []byte("LUKS\xba\xbe")})
+		_, err := openLuksDevice(device, luks)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(formatted).To(gomega.BeEmpty())
+		gomega.Expect(opened).To(gomega.Equal([]string{"hpp-pool-local"}))
+		gomega.Expect(zeroed).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should refuse to format a device with data the mounter does not recognize", func() {
+		device = writeDeviceImage(1024*1024, map[int64][]byte{0x8000: []byte("data")})
+		_, err := openLuksDevice(device, luks)
+		gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf("device %s is not blank and has no filesystem the mounter recognizes, refusing to format it with LUKS", device)))
+		gomega.Expect(formatted).To(gomega.BeEmpty())
+		gomega.Expect(opened).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should not open a device that is already opened", func() {
+		exists = true
+		mapped, err := openLuksDevice(device, luks)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(mapped).To(gomega.Equal("/dev/mapper/hpp-pool-local"))
+		gomega.Expect(formatted).To(gomega.BeEmpty())
+		gomega.Expect(opened).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should refuse to format a device with an unencrypted filesystem", func() {
+		device = writeDeviceImage(1024*1024, map[int64][]byte{0: []byte("XFSB")})
+		_, err := openLuksDevice(device, luks)
+		gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf("device %s contains an unencrypted xfs filesystem, refusing to format it with LUKS", device)))
+		gomega.Expect(formatted).To(gomega.BeEmpty())
+		gomega.Expect(opened).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should refuse to format a device with a partition table", func() {
+		device = writeDeviceImage(1024*1024, map[int64][]byte{0x1fe: {0x55, 0xaa}, 0x200: []byte("EFI PART")})
+		_, err := openLuksDevice(device, luks)
+		gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf("device %s is not blank, found a gpt signature, refusing to format it with LUKS", device)))
+		gomega.Expect(formatted).To(gomega.BeEmpty())
+		gomega.Expect(opened).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should read the key file", func() {
+		keyFile := filepath.Join(ginkgo.GinkgoT().TempDir(), "key")
+		gomega.Expect(os.WriteFile(keyFile, []byte("secret"), 0400)).To(gomega.Succeed())
+		config, err := readLuksConfig("hpp-pool-local", keyFile)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(config).To(gomega.Equal(luks))
+
+		config, err = readLuksConfig("", keyFile)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(config).To(gomega.BeNil())
+	})
+})
@@ -22,10 +22,10 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
-	"regexp"
+	"os/signal"
 	"runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	mount "github.com/moby/sys/mountinfo"
@@ -35,74 +35,31 @@ import (
 )
 
 const (
-	rhcosPrefix = "/ostree/deploy/rhcos"
+	terminationLogPath = "/dev/termination-log"
 )
 
 var (
 	log = logf.Log.WithName("mounter")
 
-	sourceRgx = regexp.MustCompile(`\[(.+)\]`)
-
-	findMntByVolume = func(volumeName string) ([]byte, error) {
-		return exec.Command("/usr/bin/findmnt", "-T", fmt.Sprintf("/%s", volumeName), "-J").CombinedOutput()
-	}
-
-	findMntBySource = func(source string) ([]byte, error) {
-		return exec.Command("/usr/bin/findmnt", "-S", source, "-J").CombinedOutput()
-	}
-
 	bindMountCommand = func(source, target string) ([]byte, error) {
 		return exec.Command("/usr/bin/mount", "-o", "bind", source, target).CombinedOutput()
 	}
 
-	mountDeviceCommand = func(source, target string) ([]byte, error) {
+	mountDeviceCommand = func(source, target string, options []string) ([]byte, error) {
+		if len(options) > 0 {
+			return exec.Command("/usr/bin/mount", "-o", strings.Join(options, ","), source, target).CombinedOutput()
+		}
 		return exec.Command("/usr/bin/mount", source, target).CombinedOutput()
 	}
 
-	unmountPathCommand = func(target string) ([]byte, error) {
+	unmountPathCommand = func(target string, lazy bool) ([]byte, error) {
+		if lazy {
+			return exec.Command("/usr/bin/umount", "--lazy", target).CombinedOutput()
+		}
 		return exec.Command("/usr/bin/umount", target).CombinedOutput()
 	}
-
-	fsTypeCommand = func(source string) ([]byte, error) {
-		return exec.Command("/usr/sbin/blkid", source, "-s", "TYPE", "-o", "value").CombinedOutput()
-	}
-
-	lsblkCommand = func(source string) ([]byte, error) {
-		return exec.Command("/usr/bin/lsblk", source, "-J").CombinedOutput()
-	}
-
-	createXfs = func(source string) ([]byte, error) {
-		return exec.Command("/usr/sbin/mkfs.xfs", source).CombinedOutput()
-	}
 )
 
-// DeviceInfo returns device information returned by lsblk
-type DeviceInfo struct {
-	Name       string `json:"name"`
-	Majmin     string `json:"maj:min"`
-	Size       string `json:"size"`
-	Type       string `json:"type"`
-	Mountpoint string `json:"mountpoint"`
-}
-
-// BlockDevices is a list of DeviceInfos from the output of lsblk
-type BlockDevices struct {
-	Blockdevices []DeviceInfo `json:"blockdevices"`
-}
-
-// FindmntInfo contains parsed findmnt -J output.
-type FindmntInfo struct {
-	Target  string `json:"target"`
-	Source  string `json:"source"`
-	Fstype  string `json:"fstype"`
-	Options string `json:"options"`
-}
-
-// FileSystems is a slice of FindmntInfo, used to parse findmnt -J output
-type FileSystems struct {
-	Filesystems []FindmntInfo `json:"filesystems"`
-}
-
 func printVersion() {
 	log.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	log.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
@@ -110,16 +67,37 @@ func printVersion() {
 
 func main() {
 	var (
-		sourcePath string
-		targetPath string
-		hostPath   string
-		unmount    bool
+		sourcePath   string
+		targetPath   string
+		hostPath     string
+		unmount      bool
+		luksName     string
+		keyFile      string
+		fsType       string
+		mkfsOptions  stringSliceFlag
+		mountOptions string
+		resync       time.Duration
+		httpAddr     string
+
+		unmountTimeout       time.Duration
+		lazyUnmount          bool
+		unmountOnTermination bool
 	)
 	flag.Set("logtostderr", "true")
 	flag.StringVar(&sourcePath, "storagePoolPath", "/source", "path the source storagePool is mounted under")
 	flag.StringVar(&targetPath, "mountPath", "/", "target path the volume should be mounted on the host")
 	flag.StringVar(&hostPath, "hostPath", "/", "path of the host in container")
 	flag.BoolVar(&unmount, "unmount", false, "set to have the target path unmounted")
+	flag.StringVar(&luksName, "luksName", "", "name of the LUKS device of an encrypted block storage pool")
+	flag.StringVar(&keyFile, "encryptionKeyFile", "", "file holding the encryption key of the LUKS device")
+	flag.StringVar(&fsType, "fsType", "", "filesystem of a block storage pool, xfs or ext4. New devices are formatted with xfs if not set")
+	flag.Var(&mkfsOptions, "mkfsOption", "extra argument passed to mkfs when formatting a block storage pool, can be repeated")
+	flag.StringVar(&mountOptions, "mountOptions", "", "comma separated options to mount a block storage pool with")
+	flag.DurationVar(&resync, "resyncInterval", time.Minute, "interval to check the storage pool mount if the mounts don't change")
+	flag.StringVar(&httpAddr, "httpAddr", ":8080", "address to serve the metrics, health and readiness endpoints on, empty to disable")
+	flag.DurationVar(&unmountTimeout, "unmountTimeout", 5*time.Minute, "how long to retry unmounting a busy storage pool, 0 to retry forever")
+	flag.BoolVar(&lazyUnmount, "lazyUnmount", false, "set to lazily unmount a storage pool that is still busy when the unmount times out")
+	flag.BoolVar(&unmountOnTermination, "unmountOnTermination", false, "set to unmount the storage pool when the mounter receives SIGTERM")
 
 	// Add the zap logger flag set to the CLI. The flag set must
 	// be added before calling pflag.Parse().
@@ -140,61 +118,56 @@ func main() {
 
 	printVersion()
 
+	luks, err := readLuksConfig(luksName, keyFile)
+	if err != nil {
+		panic(err)
+	}
+	fs := newFilesystemConfig(fsType, mkfsOptions, mountOptions)
+	grower := &filesystemGrower{}
+
 	if unmount {
-		for {
-			if !unmountPath(targetPath, hostPath) {
-				time.Sleep(time.Second)
-			} else {
-				return
-			}
+		if err := newStoragePoolUnmounter(targetPath, hostPath, luks, unmountTimeout, lazyUnmount).run(); err != nil {
+			exitWithError(err)
 		}
 	} else {
-		i := 0
-		for {
+		if err := serveHTTP(httpAddr, poolStatus, resync); err != nil {
+			panic(err)
+		}
+		watcher, err := newMountinfoWatcher(mountinfoPath)
+		if err != nil {
+			panic(err)
+		}
+		if unmountOnTermination {
+			terminate := make(chan os.Signal, 1)
+			signal.Notify(terminate, syscall.SIGTERM)
+			go func() {
+				<-terminate
+				log.Info("Received SIGTERM, unmounting the storage pool")
+				watcher.Stop()
+			}()
+		}
+		// The mounts are only checked when they change, or at the resync interval to pick up a grown device.
+		err = monitorMounts(watcher, resync, func() error {
 			isBlock, err := isBlockDevice(sourcePath)
 			if err != nil {
-				panic(err)
+				return err
 			}
-
 			if !isBlock {
 				mountFileSystemVolume(sourcePath, targetPath, hostPath)
-			} else {
-				mountBlockVolume(sourcePath, targetPath, hostPath)
-			}
-			time.Sleep(time.Second)
-			i++
-			if i%100 == 0 {
-				log.Info("Slept 100 seconds")
+			} else if err := mountBlockVolume(sourcePath, targetPath, hostPath, luks, fs, grower); err != nil {
+				return err
 			}
-		}
-	}
-}
-
-func unmountPath(targetPath, hostPath string) bool {
-	exit, err := chroot(hostPath)
-	if err != nil {
-		panic(err)
-	}
-	defer func() {
-		err := exit()
+			poolStatus.synced()
+			return nil
+		})
 		if err != nil {
-			panic(err)
+			exitWithError(err)
+		}
+		// The monitor only stops on termination
+		if err := newStoragePoolUnmounter(targetPath, hostPath, luks, unmountTimeout, lazyUnmount).run(); err != nil {
+			exitWithError(err)
 		}
-	}()
-	beforeUnmountInfos, err := lookupFindmntInfoByVolume(targetPath)
-	if err != nil {
-		log.Error(err, "unable to determine volume info", "path", targetPath)
-	}
-	out, err := unmountPathCommand(targetPath)
-	if err != nil {
-		log.Error(err, "unable to determine filesystem type on device")
-		log.Info("Output", "out", string(out))
-	}
-	afterUnmountInfos, err := lookupFindmntInfoByVolume(targetPath)
-	if err != nil {
-		log.Error(err, "unable to determine volume info", "path", targetPath)
 	}
-	return beforeUnmountInfos[0].GetSourcePath() == afterUnmountInfos[0].GetSourcePath()
 }
 
 func mountFileSystemVolume(sourcePath, targetPath, hostPath string) {
@@ -208,27 +181,40 @@ func mountFileSystemVolume(sourcePath, targetPath, hostPath string) {
 	sourceMounts, err := mount.GetMountsFromReader(mountf, mount.SingleEntryFilter(sourcePath))
 	if err != nil {
 		log.Error(err, "failed to get source mount", "sourcePath", sourcePath)
+		poolStatus.mountMissing(fmt.Sprintf("unable to get the mount of %s: %v", sourcePath, err))
 		return
 	}
 	if len(sourceMounts) == 0 {
 		log.Info("no mount point entries at sourcePath", "sourcePath", sourcePath)
+		poolStatus.mountMissing(fmt.Sprintf("the storage pool is not mounted at %s in the mounter", sourcePath))
 		return
 	}
 
 	bindMountPathOnHost(sourceMounts[0], targetPath, hostPath)
 }
 
-func mountBlockVolume(sourcePath, targetPath, hostPath string) {
-	deviceInfos, err := lookupDeviceInfoByVolume(sourcePath)
+// exitWithError exits the mounter with the error as termination message, so the reason is reported in the status of
+// the pod. A failed unmount is reported as JSON with the processes holding the storage pool.
+func exitWithError(err error) {
+	log.Error(err, "exiting")
+	message := []byte(err.Error())
+	var unmountErr *unmountError
+	if errors.As(err, &unmountErr) {
+		if structured, err := json.Marshal(unmountErr); err == nil {
+			message = structured
+		}
+	}
+	if err := os.WriteFile(terminationLogPath, message, 0644); err != nil {
+		log.Error(err, "unable to write termination message")
+	}
+	os.Exit(1)
+}
+
+func mountBlockVolume(sourcePath, targetPath, hostPath string, luks *luksConfig, fs *filesystemConfig, grower *filesystemGrower) error {
+	rawDevice, err := host.BlockDevice(sourcePath)
 	if err != nil {
 		panic(err)
 	}
-	if len(deviceInfos) > 1 {
-		log.Info("Multiple device infos found")
-	} else if len(deviceInfos) == 0 {
-		log.Info("No device info found")
-		panic("No device infos found")
-	}
 	exit, err := chroot(hostPath)
 	if err != nil {
 		panic(err)
@@ -240,20 +226,27 @@ func mountBlockVolume(sourcePath, targetPath, hostPath string) {
 		}
 	}()
 
-	// Check if filesystem exists on device
-	out, err := fsTypeCommand(deviceInfos[0].GetSourceDevice())
-	if err != nil {
-		log.Error(err, "unable to determine filesystem type on device")
-		log.Info("Output", "out", string(out))
-	}
-	if len(out) == 0 {
-		out, err := createXfs(deviceInfos[0].GetSourceDevice())
-		log.Info("Output", "out", string(out))
+	device := rawDevice
+	if luks != nil {
+		// The filesystem is created on the opened LUKS device instead of the device itself.
+		device, err = openLuksDevice(device, luks)
 		if err != nil {
 			panic(err)
 		}
 	}
-	mountIfNotMounted(targetPath, hostPath, deviceInfos[0].GetSourceDevice())
+
+	deviceFsType, err := fs.formatIfBlank(device)
+	if err != nil {
+		return err
+	}
+	if err := mountIfNotMounted(targetPath, hostPath, device, fs); err != nil {
+		return err
+	}
+	// A failed grow is retried in the next iteration, the pool stays usable at its current size.
+	if err := grower.growIfNeeded(rawDevice, device, deviceFsType, targetPath, luks); err != nil {
+		log.Error(err, "unable to grow filesystem", "device", device)
+	}
+	return nil
 }
 
 // performs a bindmount on the host if one is needed
@@ -292,6 +285,7 @@ func bindMountPathOnHost(sourceMount *mount.Info, targetPath, hostPath string) {
 	for _, m := range hostMounts {
 		// if a mount exists on the host with our targetPath as the mount point, no new bind mount is needed
 		if m.Mountpoint == targetPath {
+			poolStatus.mountFound()
 			return
 		}
 
@@ -301,11 +295,14 @@ func bindMountPathOnHost(sourceMount *mount.Info, targetPath, hostPath string) {
 			bindSource = m.Mountpoint
 		}
 	}
+	poolStatus.mountMissing(fmt.Sprintf("%s is not mounted from device %d:%d", targetPath, major, minor))
 	if bindSource != "" {
 		log.Info("Bind mounting", "source", bindSource, "target", targetPath)
 		out, err := bindMountCommand(bindSource, targetPath)
 		if err != nil {
 			log.Error(err, "failed to bind mount")
+		} else {
+			poolStatus.mountPerformed()
 		}
 		log.Info("Output", "out", string(out))
 		return
@@ -315,23 +312,20 @@ func bindMountPathOnHost(sourceMount *mount.Info, targetPath, hostPath string) {
 	os.Exit(1)
 }
 
-func mountIfNotMounted(targetPath, hostPath, hostMountPath string) {
+func mountIfNotMounted(targetPath, hostPath, hostMountPath string, fs *filesystemConfig) error {
 	// Check if path is already mounted
-	chrootInfos, err := lookupFindmntInfoByVolume(targetPath)
+	chrootInfo, err := host.MountByTarget(targetPath)
 	if err != nil {
 		log.Error(err, "unable to determine volume info", "path", targetPath)
 	}
-	if len(chrootInfos) == 0 || chrootInfos[0].GetSourcePath() != hostMountPath {
+	if chrootInfo == nil || chrootInfo.SourcePath != hostMountPath {
+		poolStatus.mountMissing(fmt.Sprintf("%s is not mounted from %s", targetPath, hostMountPath))
 		log.Info("Found mount info", "source path on host", hostMountPath)
 		log.Info("Target path", "path", targetPath)
 		log.Info("host path", "path", hostPath)
 		_, err := os.Stat(hostMountPath)
 		if os.IsNotExist(err) {
-			mountInfo, err := findMntBySource(hostMountPath)
-			if err != nil {
-				panic(err)
-			}
-			infos, err := parseMntInfoJSON(mountInfo)
+			infos, err := host.MountsBySource(hostMountPath)
 			if err != nil {
 				panic(err)
 			}
@@ -360,6 +354,8 @@ func mountIfNotMounted(targetPath, hostPath, hostMountPath string) {
 			out, err := bindMountCommand(hostMountPath, targetPath)
 			if err != nil {
 				log.Error(err, "failed to mount path on host.")
+			} else {
+				poolStatus.mountPerformed()
 			}
 			log.Info("Output", "out", string(out))
 		} else if pathInfo.Mode()&os.ModeDevice > 0 {
@@ -368,13 +364,22 @@ func mountIfNotMounted(targetPath, hostPath, hostMountPath string) {
 			if err := os.MkdirAll(targetPath, 0750); err != nil {
 				panic(err)
 			}
-			out, err := mountDeviceCommand(hostMountPath, targetPath)
+			out, err := mountDeviceCommand(hostMountPath, targetPath, fs.mountOptions)
 			if err != nil {
 				log.Error(err, "failed to mount device to path on host.")
+			} else {
+				poolStatus.mountPerformed()
 			}
 			log.Info("Output", "out", string(out))
 		}
+		return nil
+	}
+	// The device is already mounted, report a mismatch instead of remounting it.
+	if err := fs.checkMount(hostMountPath, chrootInfo); err != nil {
+		return err
 	}
+	poolStatus.mountFound()
+	return nil
 }
 
 func isBlockDevice(path string) (bool, error) {
@@ -385,59 +390,11 @@ func isBlockDevice(path string) (bool, error) {
 	return pathInfo.Mode()&os.ModeDevice > 0, nil
 }
 
-func lookupDeviceInfoByVolume(volumePath string) ([]DeviceInfo, error) {
-	deviceInfoJSON, err := lsblkCommand(volumePath)
-	if err != nil {
-		return make([]DeviceInfo, 0), err
-	}
-	blockDevices := BlockDevices{}
-	if err := json.Unmarshal(deviceInfoJSON, &blockDevices); err != nil {
-		return make([]DeviceInfo, 0), err
-	}
-	return blockDevices.Blockdevices, nil
-}
-
-// lookupFindmntInfoByVolume looks up the find mount information based on the path passed in.
-func lookupFindmntInfoByVolume(volumePath string) ([]FindmntInfo, error) {
-	mntInfoJSON, err := findMntByVolume(volumePath)
-	if err != nil {
-		return make([]FindmntInfo, 0), err
-	}
-	return parseMntInfoJSON(mntInfoJSON)
-}
-
-func parseMntInfoJSON(mntInfoJSON []byte) ([]FindmntInfo, error) {
-	mntinfo := FileSystems{}
-	if err := json.Unmarshal(mntInfoJSON, &mntinfo); err != nil {
-		return mntinfo.Filesystems, errors.Wrapf(err, "unable to unmarshal [%v]", mntInfoJSON)
-	}
-	return mntinfo.Filesystems, nil
-}
-
-// GetSourcePath returns the source part of the source field. The source field format is /dev/device[/path/on/device]
-func (f *FindmntInfo) GetSourcePath() string {
-	match := sourceRgx.FindStringSubmatch(f.Source)
-	if len(match) != 2 {
-		return strings.TrimPrefix(f.Source, rhcosPrefix)
-	}
-	return strings.TrimPrefix(match[1], rhcosPrefix)
-}
-
-// GetOptions returns a split list of all the mount options.
-func (f *FindmntInfo) GetOptions() []string {
-	return strings.Split(f.Options, ",")
-}
-
-// GetSourceDevice returns the path to the device /dev/<device>
-func (b *DeviceInfo) GetSourceDevice() string {
-	return filepath.Join("/dev", b.Name)
-}
-
 // filterGlobalMounts filters mount infos to only include CSI global mounts,
 // excluding per-pod bind mounts. This handles storage providers like CephFS
 // that create both a global mount and a per-pod bind mount.
-func filterGlobalMounts(infos []FindmntInfo) []FindmntInfo {
-	var globalMounts []FindmntInfo
+func filterGlobalMounts(infos []MountInfo) []MountInfo {
+	var globalMounts []MountInfo
 	for _, info := range infos {
 		if strings.Contains(info.Target, "/globalmount") {
 			globalMounts = append(globalMounts, info)
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	mountinfoPath = "/proc/self/mountinfo"
+)
+
+// errWatcherStopped is returned by a mount watcher once it is stopped
+var errWatcherStopped = errors.New("mount watcher stopped")
+
+// mountWatcher waits for changes to the mounts of the mount namespace of the mounter
+type mountWatcher interface {
+	// Wait blocks until the mounts change or the timeout expires, it returns true if the mounts changed. It returns
+	// errWatcherStopped once the watcher is stopped.
+	Wait(timeout time.Duration) (bool, error)
+}
+
+// mountinfoWatcher waits for mount changes by polling the mountinfo file. The kernel signals a change to the mounts
+// of the namespace with POLLPRI, reading the file again re-arms the notification. Stopping the watcher closes the
+// write end of a pipe, which wakes up the poll.
+type mountinfoWatcher struct {
+	file       *os.File
+	stopReader *os.File
+	stopWriter *os.File
+	stopOnce   sync.Once
+}
+
+func newMountinfoWatcher(path string) (*mountinfoWatcher, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	stopReader, stopWriter, err := os.Pipe()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	res := &mountinfoWatcher{
+		file:       file,
+		stopReader: stopReader,
+		stopWriter: stopWriter,
+	}
+	if err := res.read(); err != nil {
+		res.Close()
+		return nil, err
+	}
+	return res, nil
+}
+
+// Stop makes the current and all later calls to Wait return errWatcherStopped
+func (w *mountinfoWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		w.stopWriter.Close()
+	})
+}
+
+// Close releases the files of the watcher
+func (w *mountinfoWatcher) Close() {
+	w.Stop()
+	w.stopReader.Close()
+	w.file.Close()
+}
+
+func (w *mountinfoWatcher) read() error {
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := io.Copy(io.Discard, w.file)
+	return err
+}
+
+func (w *mountinfoWatcher) Wait(timeout time.Duration) (bool, error) {
+	fds := []unix.PollFd{
+		{Fd: int32(w.file.Fd()), Events: unix.POLLPRI},
+		{Fd: int32(w.stopReader.Fd()), Events: unix.POLLIN},
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false, nil
+		}
+		n, err := unix.Poll(fds, int(remaining.Milliseconds()))
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to poll %s", w.file.Name())
+		}
+		if n == 0 {
+			return false, nil
+		}
+		if fds[1].Revents != 0 {
+			return false, errWatcherStopped
+		}
+		return true, w.read()
+	}
+}
+
+// monitorMounts calls sync at startup, whenever the mounts change and at least every resync interval. It returns nil
+// once the watcher is stopped, or the error if waiting for changes or sync fails.
+func monitorMounts(watcher mountWatcher, resyncInterval time.Duration, sync func() error) error {
+	for {
+		if err := sync(); err != nil {
+			return err
+		}
+		changed, err := watcher.Wait(resyncInterval)
+		if errors.Is(err, errWatcherStopped) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if changed {
+			log.V(1).Info("Mounts changed, checking the storage pool mount")
+		} else {
+			log.V(1).Info("Resyncing the storage pool mount")
+		}
+	}
+}
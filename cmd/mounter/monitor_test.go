@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"fmt"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+)
+
+// fakeMountWatcher returns the changes in order, and an error once they are used up
+type fakeMountWatcher struct {
+	changes  []bool
+	timeouts []time.Duration
+}
+
+func (w *fakeMountWatcher) Wait(timeout time.Duration) (bool, error) {
+	w.timeouts = append(w.timeouts, timeout)
+	if len(w.changes) == 0 {
+		return false, fmt.Errorf("no more changes")
+	}
+	changed := w.changes[0]
+	w.changes = w.changes[1:]
+	return changed, nil
+}
+
+var _ = ginkgo.Describe("Mount monitor tests", func() {
+	ginkgo.It("should sync at startup, on mount changes and on resync", func() {
+		watcher := &fakeMountWatcher{
+			changes: []bool{true, false, true},
+		}
+		syncs := 0
+		err := monitorMounts(watcher, time.Minute, func() error {
+			syncs++
+			return nil
+		})
+		gomega.Expect(err).To(gomega.MatchError("no more changes"))
+		gomega.Expect(syncs).To(gomega.Equal(4))
+		gomega.Expect(watcher.timeouts).To(gomega.HaveEach(time.Minute))
+	})
+
+	ginkgo.It("should stop when sync fails", func() {
+		watcher := &fakeMountWatcher{
+			changes: []bool{true, true},
+		}
+		syncs := 0
+		err := monitorMounts(watcher, time.Minute, func() error {
+			syncs++
+			if syncs == 2 {
+				return fmt.Errorf("device is formatted with xfs instead of ext4")
+			}
+			return nil
+		})
+		gomega.Expect(err).To(gomega.MatchError("device is formatted with xfs instead of ext4"))
+		gomega.Expect(watcher.changes).To(gomega.HaveLen(1))
+	})
+
+	ginkgo.It("should time out waiting for changes of the mountinfo", func() {
+		watcher, err := newMountinfoWatcher(mountinfoPath)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		defer watcher.Close()
+		changed, err := watcher.Wait(10 * time.Millisecond)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(changed).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should stop waiting for changes of the mountinfo once stopped", func() {
+		watcher, err := newMountinfoWatcher(mountinfoPath)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		defer watcher.Close()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			watcher.Stop()
+		}()
+		_, err = watcher.Wait(time.Minute)
+		gomega.Expect(err).To(gomega.MatchError(errWatcherStopped))
+		watcher.Stop()
+		syncs := 0
+		gomega.Expect(monitorMounts(watcher, time.Minute, func() error {
+			syncs++
+			return nil
+		})).To(gomega.Succeed())
+		gomega.Expect(syncs).To(gomega.Equal(1))
+	})
+})
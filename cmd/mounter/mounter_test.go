@@ -23,7 +23,7 @@ import (
 var _ = ginkgo.Describe("Mounter tests", func() {
 	ginkgo.Context("filterGlobalMounts", func() {
 		ginkgo.It("should filter to global mount when multiple mounts exist", func() {
-			infos := []FindmntInfo{
+			infos := []MountInfo{
 				{
 					Target: "/var/lib/kubelet/plugins/kubernetes.io/csi/openshift-storage.cephfs.csi.ceph.com/abc123/globalmount",
 					Source: "csi-cephfs-node@cluster.cephfs=/volumes/csi/csi-vol-123/abc",
@@ -39,7 +39,7 @@ var _ = ginkgo.Describe("Mounter tests", func() {
 		})
 
 		ginkgo.It("should return empty when no global mount is found", func() {
-			infos := []FindmntInfo{
+			infos := []MountInfo{
 				{
 					Target: "/var/lib/kubelet/pods/pod-uid-1/volumes/kubernetes.io~csi/pvc-1/mount",
 					Source: "some-source",
@@ -54,7 +54,7 @@ var _ = ginkgo.Describe("Mounter tests", func() {
 		})
 
 		ginkgo.It("should return single global mount unchanged", func() {
-			infos := []FindmntInfo{
+			infos := []MountInfo{
 				{
 					Target: "/var/lib/kubelet/plugins/kubernetes.io/csi/driver/abc123/globalmount",
 					Source: "nfs-server:/share",
@@ -64,32 +64,4 @@ var _ = ginkgo.Describe("Mounter tests", func() {
 			gomega.Expect(result).To(gomega.HaveLen(1))
 		})
 	})
-
-	ginkgo.Context("lsblk JSON parsing", func() {
-		intJSON := `{
-			"blockdevices": [
-			   {"name": "vdb", "maj:min": "252:16", "rm": "1", "size": "120G", "ro": "0", "type": "disk", "mountpoint": "/host/var/hpvolumes/csi"}
-			]
-		 }`
-		boolJSON := `{
-			"blockdevices": [
-			   {"name":"rbd0", "maj:min":"251:0", "rm":true, "size":"120G", "ro":false, "type":"disk", "mountpoint":"/host/var/hpvolumes/csi"}
-			]
-		 }`
-
-		ginkgo.DescribeTable("should not panic over json booleans with", func(jsonStr string) {
-			// Override lsblk cmd
-			lsblkCommand = func(source string) ([]byte, error) {
-				gomega.Expect(source).To(gomega.Equal("test"))
-				return []byte(jsonStr), nil
-			}
-
-			deviceInfos, err := lookupDeviceInfoByVolume("test")
-			gomega.Expect(err).ToNot(gomega.HaveOccurred())
-			gomega.Expect(deviceInfos[0].Size).To(gomega.Equal("120G"))
-		},
-			ginkgo.Entry("integer value", intJSON),
-			ginkgo.Entry("actual boolean", boolJSON),
-		)
-	})
 })
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	procPath             = "/proc"
+	unmountRetryInterval = time.Second
+
+	// unmountTimedOutReason is the reason of the termination message when the storage pool is still busy at the
+	// deadline.
+	unmountTimedOutReason = "UnmountTimedOut"
+	// luksCloseTimedOutReason is the reason of the termination message when the LUKS device of the storage pool is
+	// still open at the deadline.
+	luksCloseTimedOutReason = "LUKSCloseTimedOut"
+)
+
+// unmountError is a failed unmount of the storage pool, it is written as JSON termination message so the holders of
+// a busy pool show up in the status of the pod.
+type unmountError struct {
+	Reason  string        `json:"reason"`
+	Target  string        `json:"target"`
+	Message string        `json:"message"`
+	Holders []mountHolder `json:"holders,omitempty"`
+}
+
+func (e *unmountError) Error() string {
+	return e.Message
+}
+
+// mountHolder is a process that keeps a mount busy
+type mountHolder struct {
+	PID     int    `json:"pid"`
+	Command string `json:"command"`
+}
+
+// storagePoolUnmounter unmounts the storage pool from the host and closes its LUKS device, retrying while the pool
+// is busy until the timeout expires.
+type storagePoolUnmounter struct {
+	targetPath string
+	// timeout is how long to retry, zero retries forever
+	timeout time.Duration
+	// lazy detaches the busy mount at the deadline instead of failing
+	lazy bool
+	luks *luksConfig
+
+	// unmount returns true once the target is no longer a mount point
+	unmount func(lazy bool) bool
+	// closeLuks returns true once the LUKS device is closed, or scheduled to be closed if deferred
+	closeLuks func(deferred bool) bool
+	// holders returns the processes keeping the target busy
+	holders func() []mountHolder
+	now     func() time.Time
+	sleep   func(time.Duration)
+}
+
+func newStoragePoolUnmounter(targetPath, hostPath string, luks *luksConfig, timeout time.Duration, lazy bool) *storagePoolUnmounter {
+	return &storagePoolUnmounter{
+		targetPath: targetPath,
+		timeout:    timeout,
+		lazy:       lazy,
+		luks:       luks,
+		unmount: func(lazy bool) bool {
+			return unmountPath(targetPath, hostPath, lazy)
+		},
+		closeLuks: func(deferred bool) bool {
+			return closeLuksDevice(luks, hostPath, deferred)
+		},
+		holders: func() []mountHolder {
+			return findHostMountHolders(targetPath, hostPath)
+		},
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+}
+
+// run unmounts the storage pool. The processes holding a busy pool are logged once, and returned in the error if the
+// pool is still busy at the deadline.
+func (u *storagePoolUnmounter) run() error {
+	deadline := u.now().Add(u.timeout)
+	expired := func() bool {
+		return u.timeout > 0 && u.now().After(deadline)
+	}
+	lazilyUnmounted := false
+	for attempt := 0; !u.unmount(false); attempt++ {
+		if attempt == 0 {
+			log.Info("Storage pool is busy, retrying to unmount it", "target", u.targetPath, "timeout", u.timeout, "holders", u.holders())
+		}
+		if expired() {
+			holders := u.holders()
+			if !u.lazy {
+				return &unmountError{
+					Reason:  unmountTimedOutReason,
+					Target:  u.targetPath,
+					Message: fmt.Sprintf("unable to unmount %s within %s, it is held by %s", u.targetPath, u.timeout, formatHolders(holders)),
+					Holders: holders,
+				}
+			}
+			log.Info("Lazily unmounting busy storage pool", "target", u.targetPath, "holders", holders)
+			if !u.unmount(true) {
+				return &unmountError{
+					Reason:  unmountTimedOutReason,
+					Target:  u.targetPath,
+					Message: fmt.Sprintf("unable to lazily unmount %s", u.targetPath),
+					Holders: holders,
+				}
+			}
+			lazilyUnmounted = true
+			break
+		}
+		u.sleep(unmountRetryInterval)
+	}
+	if u.luks == nil {
+		return nil
+	}
+	// The filesystem of a lazily unmounted pool is still in use, the device can only be removed once it is released.
+	for !u.closeLuks(lazilyUnmounted) {
+		if expired() {
+			if u.lazy && !lazilyUnmounted {
+				lazilyUnmounted = true
+				continue
+			}
+			return &unmountError{
+				Reason:  luksCloseTimedOutReason,
+				Target:  u.targetPath,
+				Message: fmt.Sprintf("unable to close LUKS device %s within %s", u.luks.name, u.timeout),
+			}
+		}
+		u.sleep(unmountRetryInterval)
+	}
+	return nil
+}
+
+func formatHolders(holders []mountHolder) string {
+	if len(holders) == 0 {
+		return "no known process"
+	}
+	res := make([]string, 0, len(holders))
+	for _, holder := range holders {
+		res = append(res, fmt.Sprintf("%s (%d)", holder.Command, holder.PID))
+	}
+	return strings.Join(res, ", ")
+}
+
+// unmountPath unmounts the target path on the host, returns true if the target is no longer a mount point.
+func unmountPath(targetPath, hostPath string, lazy bool) bool {
+	exit, err := chroot(hostPath)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		err := exit()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	mounted, err := isMountPoint(targetPath)
+	if err != nil {
+		log.Error(err, "unable to determine volume info", "path", targetPath)
+		return false
+	}
+	if !mounted {
+		return true
+	}
+	out, err := unmountPathCommand(targetPath, lazy)
+	if err != nil {
+		log.Error(err, "unable to unmount path", "path", targetPath, "lazy", lazy)
+		log.Info("Output", "out", string(out))
+		return false
+	}
+	// Mounts can be stacked on the target, it is checked again in the next attempt.
+	mounted, err = isMountPoint(targetPath)
+	if err != nil {
+		log.Error(err, "unable to determine volume info", "path", targetPath)
+		return false
+	}
+	return !mounted
+}
+
+// isMountPoint returns true if a mount is mounted on the path
+func isMountPoint(path string) (bool, error) {
+	info, err := host.MountByTarget(path)
+	if err != nil {
+		return false, err
+	}
+	target := filepath.Clean(path)
+	if resolved, err := filepath.EvalSymlinks(target); err == nil {
+		target = resolved
+	}
+	return info.Target == target, nil
+}
+
+// findHostMountHolders returns the processes of the host keeping the target busy
+func findHostMountHolders(targetPath, hostPath string) []mountHolder {
+	exit, err := chroot(hostPath)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		err := exit()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	return findMountHolders(procPath, targetPath)
+}
+
+// findMountHolders returns the processes that have their working directory, root, executable or an open file below
+// the target, like fuser -m. Processes that exit while they are inspected are skipped.
+func findMountHolders(procDir, targetPath string) []mountHolder {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		log.Error(err, "unable to list processes", "path", procDir)
+		return nil
+	}
+	res := make([]mountHolder, 0)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		processPath := filepath.Join(procDir, entry.Name())
+		if !holdsPath(processPath, targetPath) {
+			continue
+		}
+		command, err := os.ReadFile(filepath.Join(processPath, "comm"))
+		if err != nil {
+			continue
+		}
+		res = append(res, mountHolder{
+			PID:     pid,
+			Command: strings.TrimSpace(string(command)),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PID < res[j].PID
+	})
+	return res
+}
+
+func holdsPath(processPath, targetPath string) bool {
+	links := []string{"cwd", "root", "exe"}
+	if fds, err := os.ReadDir(filepath.Join(processPath, "fd")); err == nil {
+		for _, fd := range fds {
+			links = append(links, filepath.Join("fd", fd.Name()))
+		}
+	}
+	for _, link := range links {
+		dest, err := os.Readlink(filepath.Join(processPath, link))
+		if err == nil && isPathUnder(dest, targetPath) {
+			return true
+		}
+	}
+	return false
+}
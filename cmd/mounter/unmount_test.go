@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("Unmount tests", func() {
+	const target = "/var/hpvolumes/csi"
+
+	var (
+		now       time.Time
+		busyUntil time.Time
+		unmounts  []bool
+		closes    []bool
+		closeErr  bool
+		unmounter *storagePoolUnmounter
+		holders   = []mountHolder{{PID: 4242, Command: "qemu-kvm"}}
+	)
+
+	ginkgo.BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		busyUntil = now
+		unmounts = nil
+		closes = nil
+		closeErr = false
+		unmounter = &storagePoolUnmounter{
+			targetPath: target,
+			timeout:    time.Minute,
+			unmount: func(lazy bool) bool {
+				unmounts = append(unmounts, lazy)
+				return lazy || !now.Before(busyUntil)
+			},
+			closeLuks: func(deferred bool) bool {
+				closes = append(closes, deferred)
+				return deferred || !closeErr
+			},
+			holders: func() []mountHolder {
+				return holders
+			},
+			now: func() time.Time {
+				return now
+			},
+			sleep: func(d time.Duration) {
+				now = now.Add(d)
+			},
+		}
+	})
+
+	ginkgo.It("should retry unmounting a busy storage pool", func() {
+		busyUntil = now.Add(10 * time.Second)
+		gomega.Expect(unmounter.run()).To(gomega.Succeed())
+		gomega.Expect(unmounts).To(gomega.HaveLen(11))
+		gomega.Expect(unmounts).To(gomega.HaveEach(false))
+	})
+
+	ginkgo.It("should fail with the holders once the timeout expires", func() {
+		busyUntil = now.Add(time.Hour)
+		err := unmounter.run()
+		gomega.Expect(err).To(gomega.MatchError("unable to unmount /var/hpvolumes/csi within 1m0s, it is held by qemu-kvm (4242)"))
+		unmountErr, ok := err.(*unmountError)
+		gomega.Expect(ok).To(gomega.BeTrue())
+		gomega.Expect(unmountErr.Reason).To(gomega.Equal(unmountTimedOutReason))
+		gomega.Expect(unmountErr.Holders).To(gomega.Equal(holders))
+		message, err := json.Marshal(unmountErr)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(string(message)).To(gomega.Equal(`{"reason":"UnmountTimedOut","target":"/var/hpvolumes/csi","message":"unable to unmount /var/hpvolumes/csi within 1m0s, it is held by qemu-kvm (4242)","holders":[{"pid":4242,"command":"qemu-kvm"}]}`))
+		gomega.Expect(unmounts).To(gomega.HaveEach(false))
+	})
+
+	ginkgo.It("should lazily unmount a busy storage pool and defer closing the LUKS device", func() {
+		busyUntil = now.Add(time.Hour)
+		unmounter.lazy = true
+		unmounter.luks = &luksConfig{name: "hpp-pool-local"}
+		gomega.Expect(unmounter.run()).To(gomega.Succeed())
+		gomega.Expect(unmounts[len(unmounts)-1]).To(gomega.BeTrue())
+		gomega.Expect(closes).To(gomega.Equal([]bool{true}))
+	})
+
+	ginkgo.It("should close the LUKS device after unmounting the storage pool", func() {
+		unmounter.luks = &luksConfig{name: "hpp-pool-local"}
+		gomega.Expect(unmounter.run()).To(gomega.Succeed())
+		gomega.Expect(unmounts).To(gomega.Equal([]bool{false}))
+		gomega.Expect(closes).To(gomega.Equal([]bool{false}))
+	})
+
+	ginkgo.It("should fail if the LUKS device is not closed before the timeout expires", func() {
+		unmounter.luks = &luksConfig{name: "hpp-pool-local"}
+		closeErr = true
+		err := unmounter.run()
+		gomega.Expect(err).To(gomega.MatchError("unable to close LUKS device hpp-pool-local within 1m0s"))
+		gomega.Expect(err.(*unmountError).Reason).To(gomega.Equal(luksCloseTimedOutReason))
+		gomega.Expect(closes).To(gomega.HaveEach(false))
+	})
+
+	ginkgo.It("should find the processes holding the storage pool", func() {
+		proc := ginkgo.GinkgoT().TempDir()
+		process := func(pid, command string, links map[string]string) {
+			dir := filepath.Join(proc, pid)
+			gomega.Expect(os.MkdirAll(filepath.Join(dir, "fd"), 0755)).To(gomega.Succeed())
+			gomega.Expect(os.WriteFile(filepath.Join(dir, "comm"), []byte(command+"\n"), 0644)).To(gomega.Succeed())
+			for link, dest := range links {
+				gomega.Expect(os.Symlink(dest, filepath.Join(dir, link))).To(gomega.Succeed())
+			}
+		}
+		process("1", "systemd", map[string]string{"cwd": "/", "root": "/", "exe": "/usr/lib/systemd/systemd"})
+		process("4242", "qemu-kvm", map[string]string{"cwd": "/", "fd/12": "/var/hpvolumes/csi/pvc-1/disk.img"})
+		process("731", "bash", map[string]string{"cwd": "/var/hpvolumes/csi"})
+		process("802", "ls", map[string]string{"cwd": "/var/hpvolumes/csi-backup"})
+		gomega.Expect(os.MkdirAll(filepath.Join(proc, "self"), 0755)).To(gomega.Succeed())
+		gomega.Expect(findMountHolders(proc, target)).To(gomega.Equal([]mountHolder{
+			{PID: 731, Command: "bash"},
+			{PID: 4242, Command: "qemu-kvm"},
+		}))
+		gomega.Expect(formatHolders(nil)).To(gomega.Equal("no known process"))
+	})
+})
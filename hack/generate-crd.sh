@@ -23,6 +23,13 @@ function ensureControllerGen() {
 ensureControllerGen
 controller-gen --version
 controller-gen crd:crdVersions=v1 output:dir=./deploy/ paths=./pkg/apis/hostpathprovisioner/...
+# controller-gen doesn't generate the conversion webhook configuration, add it together with the cert-manager CA injection
+sed -i '/^  group: hostpathprovisioner.kubevirt.io$/r hack/crd-conversion.yaml' deploy/hostpathprovisioner.kubevirt.io_hostpathprovisioners.yaml
+sed -i '/^    controller-gen.kubebuilder.io\/version:/a\    cert-manager.io/inject-ca-from: hostpath-provisioner/hostpath-provisioner-operator-webhook-service-cert' deploy/hostpathprovisioner.kubevirt.io_hostpathprovisioners.yaml
+# Both versions of the NodeStoragePool CRD have the same schema and need no conversion webhook, add it after the
+# HostPathProvisioner CRD
+cat deploy/hostpathprovisioner.kubevirt.io_nodestoragepools.yaml >> deploy/hostpathprovisioner.kubevirt.io_hostpathprovisioners.yaml
+rm deploy/hostpathprovisioner.kubevirt.io_nodestoragepools.yaml
 
 # First remove the CRD from operator.yaml and replace it with a marker #######
 sed -z 's/---\napiVersion: apiextensions\.k8s\.io\/v1\nkind: CustomResourceDefinition.*---/######\n---/' ./deploy/operator.yaml > ./deploy/operator.tmp.yaml
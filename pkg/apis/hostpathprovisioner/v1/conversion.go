@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+// PathConfigAnnotation stores the deprecated v1beta1 pathConfig on v1 objects. v1 has no pathConfig field, the
+// annotation allows a legacy CR to survive a round trip through v1 without losing its configuration.
+const PathConfigAnnotation = "hostpathprovisioner.kubevirt.io/v1beta1-path-config"
+
+var _ conversion.Convertible = &HostPathProvisioner{}
+
+// ConvertTo converts this HostPathProvisioner to the hub (v1beta1) version.
+func (r *HostPathProvisioner) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1beta1.HostPathProvisioner)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub type %T", dstRaw)
+	}
+	r.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1beta1.HostPathProvisionerSpec{}
+	if err := convertViaJSON(&r.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	dst.Status = v1beta1.HostPathProvisionerStatus{}
+	if err := convertViaJSON(&r.Status, &dst.Status); err != nil {
+		return err
+	}
+	if value, ok := dst.Annotations[PathConfigAnnotation]; ok {
+		pathConfig := &v1beta1.PathConfig{}
+		if err := json.Unmarshal([]byte(value), pathConfig); err != nil {
+			return fmt.Errorf("unable to parse annotation %s: %v", PathConfigAnnotation, err)
+		}
+		dst.Spec.PathConfig = pathConfig
+		delete(dst.Annotations, PathConfigAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub (v1beta1) version to this HostPathProvisioner.
+func (r *HostPathProvisioner) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1beta1.HostPathProvisioner)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub type %T", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&r.ObjectMeta)
+	r.Spec = HostPathProvisionerSpec{}
+	if err := convertViaJSON(&src.Spec, &r.Spec); err != nil {
+		return err
+	}
+	r.Status = HostPathProvisionerStatus{}
+	if err := convertViaJSON(&src.Status, &r.Status); err != nil {
+		return err
+	}
+	if src.Spec.PathConfig != nil {
+		value, err := json.Marshal(src.Spec.PathConfig)
+		if err != nil {
+			return err
+		}
+		if r.Annotations == nil {
+			r.Annotations = make(map[string]string)
+		}
+		r.Annotations[PathConfigAnnotation] = string(value)
+	}
+	return nil
+}
+
+// convertViaJSON copies in to out using the json representation. Both versions share the same json layout, except
+// for pathConfig, which is kept in an annotation. A field that only exists in one version would be dropped, the round
+// trip tests fill every field of both versions to catch that.
+func convertViaJSON(in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
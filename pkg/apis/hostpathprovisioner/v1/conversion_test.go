@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/randfill"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+var _ = ginkgo.Describe("Conversion", func() {
+	snapshotProvider := "reflink"
+
+	createV1beta1StoragePoolCr := func() *v1beta1.HostPathProvisioner {
+		return &v1beta1.HostPathProvisioner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "hostpath-provisioner",
+				Labels: map[string]string{"label": "value"},
+			},
+			Spec: v1beta1.HostPathProvisionerSpec{
+				ImagePullPolicy: corev1.PullAlways,
+				Workload: v1beta1.NodePlacement{
+					NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+				},
+				FeatureGates: []string{"Snapshotting"},
+				StoragePools: []v1beta1.StoragePool{
+					{
+						Name: "local",
+						Path: "/var/hpvolumes",
+						PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+							AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: resource.MustParse("5Gi"),
+								},
+							},
+						},
+						SnapshotProvider: &snapshotProvider,
+						OverlayClassName: "overlay",
+					},
+				},
+			},
+			Status: v1beta1.HostPathProvisionerStatus{
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionAvailable,
+						Status: corev1.ConditionTrue,
+					},
+				},
+				ObservedVersion: "1.0.0",
+				StoragePoolStatuses: []v1beta1.StoragePoolStatus{
+					{
+						Name:         "local",
+						Phase:        v1beta1.StoragePoolReady,
+						DesiredReady: 1,
+						CurrentReady: 1,
+					},
+				},
+			},
+		}
+	}
+
+	ginkgo.It("should round trip a storage pool CR", func() {
+		hub := createV1beta1StoragePoolCr()
+		spoke := &HostPathProvisioner{}
+		gomega.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+		gomega.Expect(spoke.Name).To(gomega.Equal(hub.Name))
+		gomega.Expect(spoke.Labels).To(gomega.Equal(hub.Labels))
+		gomega.Expect(spoke.Annotations).ToNot(gomega.HaveKey(PathConfigAnnotation))
+		gomega.Expect(spoke.Spec.ImagePullPolicy).To(gomega.Equal(corev1.PullAlways))
+		gomega.Expect(spoke.Spec.StoragePools).To(gomega.HaveLen(1))
+		gomega.Expect(spoke.Spec.StoragePools[0].Path).To(gomega.Equal("/var/hpvolumes"))
+		gomega.Expect(*spoke.Spec.StoragePools[0].SnapshotProvider).To(gomega.Equal(snapshotProvider))
+		gomega.Expect(spoke.Status.StoragePoolStatuses).To(gomega.HaveLen(1))
+		gomega.Expect(spoke.Status.StoragePoolStatuses[0].Phase).To(gomega.Equal(StoragePoolReady))
+
+		result := &v1beta1.HostPathProvisioner{}
+		gomega.Expect(spoke.ConvertTo(result)).To(gomega.Succeed())
+		gomega.Expect(result.ObjectMeta).To(gomega.Equal(hub.ObjectMeta))
+		gomega.Expect(result.Spec.StoragePools[0].PVCTemplate.Resources.Requests.Storage().Equal(resource.MustParse("5Gi"))).To(gomega.BeTrue())
+		result.Spec.StoragePools[0].PVCTemplate = nil
+		hub.Spec.StoragePools[0].PVCTemplate = nil
+		gomega.Expect(result.Spec).To(gomega.Equal(hub.Spec))
+		gomega.Expect(result.Status).To(gomega.Equal(hub.Status))
+	})
+
+	// newFiller fills every field of the objects, so a field that only exists in one version fails the round trip. The
+	// quantities and times are filled with values that survive their json representation.
+	newFiller := func(seed int64) *randfill.Filler {
+		return randfill.NewWithSeed(seed).NilChance(0.2).NumElements(1, 2).Funcs(
+			func(q *resource.Quantity, c randfill.Continue) {
+				*q = *resource.NewQuantity(c.Int63n(1<<40), resource.BinarySI)
+			},
+			func(t *metav1.Time, c randfill.Continue) {
+				*t = metav1.NewTime(time.Unix(c.Int63n(1<<32), 0))
+			},
+		)
+	}
+
+	ginkgo.It("should round trip every field of the hub", func() {
+		for seed := int64(0); seed < 100; seed++ {
+			hub := &v1beta1.HostPathProvisioner{}
+			newFiller(seed).Fill(hub)
+			spoke := &HostPathProvisioner{}
+			gomega.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+			result := &v1beta1.HostPathProvisioner{}
+			gomega.Expect(spoke.ConvertTo(result)).To(gomega.Succeed())
+			gomega.Expect(equality.Semantic.DeepEqual(result.ObjectMeta, hub.ObjectMeta)).To(gomega.BeTrue(), "seed %d", seed)
+			gomega.Expect(equality.Semantic.DeepEqual(result.Spec, hub.Spec)).To(gomega.BeTrue(), "seed %d", seed)
+			gomega.Expect(equality.Semantic.DeepEqual(result.Status, hub.Status)).To(gomega.BeTrue(), "seed %d", seed)
+		}
+	})
+
+	ginkgo.It("should round trip every field of v1", func() {
+		for seed := int64(0); seed < 100; seed++ {
+			spoke := &HostPathProvisioner{}
+			newFiller(seed).Fill(spoke)
+			delete(spoke.Annotations, PathConfigAnnotation)
+			hub := &v1beta1.HostPathProvisioner{}
+			gomega.Expect(spoke.ConvertTo(hub)).To(gomega.Succeed())
+			result := &HostPathProvisioner{}
+			gomega.Expect(result.ConvertFrom(hub)).To(gomega.Succeed())
+			gomega.Expect(equality.Semantic.DeepEqual(result.ObjectMeta, spoke.ObjectMeta)).To(gomega.BeTrue(), "seed %d", seed)
+			gomega.Expect(equality.Semantic.DeepEqual(result.Spec, spoke.Spec)).To(gomega.BeTrue(), "seed %d", seed)
+			gomega.Expect(equality.Semantic.DeepEqual(result.Status, spoke.Status)).To(gomega.BeTrue(), "seed %d", seed)
+		}
+	})
+
+	ginkgo.It("should preserve the legacy path config in an annotation", func() {
+		hub := &v1beta1.HostPathProvisioner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "hostpath-provisioner",
+			},
+			Spec: v1beta1.HostPathProvisionerSpec{
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				PathConfig: &v1beta1.PathConfig{
+					Path:            "/var/hpvolumes",
+					UseNamingPrefix: true,
+				},
+			},
+		}
+		spoke := &HostPathProvisioner{}
+		gomega.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+		gomega.Expect(spoke.Annotations).To(gomega.HaveKeyWithValue(PathConfigAnnotation, `{"path":"/var/hpvolumes","useNamingPrefix":true}`))
+		gomega.Expect(spoke.Spec.StoragePools).To(gomega.BeEmpty())
+
+		result := &v1beta1.HostPathProvisioner{}
+		gomega.Expect(spoke.ConvertTo(result)).To(gomega.Succeed())
+		gomega.Expect(result.Annotations).To(gomega.BeNil())
+		gomega.Expect(result.Spec).To(gomega.Equal(hub.Spec))
+		// The source object is not modified by the conversion
+		gomega.Expect(spoke.Annotations).To(gomega.HaveKey(PathConfigAnnotation))
+	})
+
+	ginkgo.It("should have the same json layout for NodeStoragePool in both versions", func() {
+		// The NodeStoragePool CRD has no conversion webhook, the API server only changes the apiVersion
+		for seed := int64(0); seed < 100; seed++ {
+			nodeStoragePool := &NodeStoragePool{}
+			newFiller(seed).Fill(&nodeStoragePool.Spec)
+			newFiller(seed).Fill(&nodeStoragePool.Status)
+			hub := &v1beta1.NodeStoragePool{}
+			gomega.Expect(convertViaJSON(nodeStoragePool, hub)).To(gomega.Succeed())
+			result := &NodeStoragePool{}
+			gomega.Expect(convertViaJSON(hub, result)).To(gomega.Succeed())
+			gomega.Expect(equality.Semantic.DeepEqual(result, nodeStoragePool)).To(gomega.BeTrue(), "seed %d", seed)
+
+			hub = &v1beta1.NodeStoragePool{}
+			newFiller(seed).Fill(&hub.Spec)
+			newFiller(seed).Fill(&hub.Status)
+			spoke := &NodeStoragePool{}
+			gomega.Expect(convertViaJSON(hub, spoke)).To(gomega.Succeed())
+			hubResult := &v1beta1.NodeStoragePool{}
+			gomega.Expect(convertViaJSON(spoke, hubResult)).To(gomega.Succeed())
+			gomega.Expect(equality.Semantic.DeepEqual(hubResult, hub)).To(gomega.BeTrue(), "seed %d", seed)
+		}
+	})
+
+	ginkgo.It("should keep other annotations when restoring the path config", func() {
+		spoke := &HostPathProvisioner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "hostpath-provisioner",
+				Annotations: map[string]string{
+					"other":              "value",
+					PathConfigAnnotation: `{"path":"/var/hpvolumes"}`,
+				},
+			},
+		}
+		result := &v1beta1.HostPathProvisioner{}
+		gomega.Expect(spoke.ConvertTo(result)).To(gomega.Succeed())
+		gomega.Expect(result.Annotations).To(gomega.Equal(map[string]string{"other": "value"}))
+		gomega.Expect(result.Spec.PathConfig).To(gomega.Equal(&v1beta1.PathConfig{Path: "/var/hpvolumes"}))
+	})
+
+	ginkgo.It("should fail on an invalid path config annotation", func() {
+		spoke := &HostPathProvisioner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "hostpath-provisioner",
+				Annotations: map[string]string{
+					PathConfigAnnotation: "invalid",
+				},
+			},
+		}
+		result := &v1beta1.HostPathProvisioner{}
+		gomega.Expect(spoke.ConvertTo(result)).ToNot(gomega.Succeed())
+	})
+})
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+var _ = ginkgo.Describe("Webhook", func() {
+	createCr := func() *HostPathProvisioner {
+		return &HostPathProvisioner{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: SchemeGroupVersion.String(),
+				Kind:       "HostPathProvisioner",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "hostpath-provisioner",
+			},
+			Spec: HostPathProvisionerSpec{
+				StoragePools: []StoragePool{
+					{
+						Name: "local",
+						Path: "/var/hpvolumes",
+					},
+				},
+			},
+		}
+	}
+
+	// createRequest returns the admission request the api server sends for the object
+	createRequest := func(obj runtime.Object, version string) admission.Request {
+		raw, err := json.Marshal(obj)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       "uid",
+				Operation: admissionv1.Create,
+				Kind: metav1.GroupVersionKind{
+					Group:   SchemeGroupVersion.Group,
+					Version: version,
+					Kind:    "HostPathProvisioner",
+				},
+				Object: runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+
+	createValidatingWebhook := func() *admission.Webhook {
+		scheme := runtime.NewScheme()
+		gomega.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+		gomega.Expect(v1beta1.AddToScheme(scheme)).To(gomega.Succeed())
+		return admission.WithValidator[*v1beta1.HostPathProvisioner](scheme, &v1beta1.HostPathProvisionerValidator{})
+	}
+
+	ginkgo.It("Should validate a v1 request converted to v1beta1 by the api server", func() {
+		cr := createCr()
+		hub := &v1beta1.HostPathProvisioner{}
+		gomega.Expect(cr.ConvertTo(hub)).To(gomega.Succeed())
+		hub.APIVersion = v1beta1.SchemeGroupVersion.String()
+		hub.Kind = "HostPathProvisioner"
+
+		res := createValidatingWebhook().Handle(context.TODO(), createRequest(hub, v1beta1.SchemeGroupVersion.Version))
+		gomega.Expect(res.Allowed).To(gomega.BeTrue(), res.Result.String())
+
+		ginkgo.By("Converting an invalid v1 CR, it should reject it")
+		cr.Spec.StoragePools[0].Path = ""
+		gomega.Expect(cr.ConvertTo(hub)).To(gomega.Succeed())
+		res = createValidatingWebhook().Handle(context.TODO(), createRequest(hub, v1beta1.SchemeGroupVersion.Version))
+		gomega.Expect(res.Allowed).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("Should not be able to decode a v1 request in the v1beta1 webhook", func() {
+		res := createValidatingWebhook().Handle(context.TODO(), createRequest(createCr(), SchemeGroupVersion.Version))
+		gomega.Expect(res.Allowed).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("Should only send v1beta1 requests to the webhooks, the api server converts the v1 requests", func() {
+		content, err := os.ReadFile("../../../../deploy/webhook.yaml")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		webhooks := 0
+		for _, document := range strings.Split(string(content), "\n---\n") {
+			config := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+			gomega.Expect(yaml.Unmarshal([]byte(document), config)).To(gomega.Succeed())
+			if !strings.HasSuffix(config.Kind, "WebhookConfiguration") {
+				continue
+			}
+			for _, webhook := range config.Webhooks {
+				webhooks++
+				gomega.Expect(webhook.MatchPolicy).To(gomega.HaveValue(gomega.Equal(admissionregistrationv1.Equivalent)), webhook.Name)
+				gomega.Expect(webhook.ClientConfig.Service.Path).To(gomega.HaveValue(gomega.ContainSubstring("-v1beta1-")), webhook.Name)
+				for _, rule := range webhook.Rules {
+					gomega.Expect(rule.APIVersions).To(gomega.Equal([]string{v1beta1.SchemeGroupVersion.Version}), webhook.Name)
+				}
+			}
+		}
+		gomega.Expect(webhooks).To(gomega.Equal(2))
+	})
+})
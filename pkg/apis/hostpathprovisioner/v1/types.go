@@ -0,0 +1,528 @@
+/*
+Copyright 2019 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HostPathProvisionerSpec defines the desired state of HostPathProvisioner
+// +k8s:openapi-gen=true
+type HostPathProvisionerSpec struct {
+	// ImagePullPolicy is the container pull policy for the host path provisioner containers
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty" valid:"required"`
+	// Restrict on which nodes HPP workload pods will be scheduled
+	Workload NodePlacement `json:"workload,omitempty"`
+	// FeatureGates are a list of specific enabled feature gates
+	// +listType=set
+	FeatureGates []string `json:"featureGates,omitempty"`
+	// StoragePools are a list of storage pools
+	// +listType=atomic
+	StoragePools []StoragePool `json:"storagePools,omitempty" optional:"true"`
+	// StorageClasses are a list of storage classes the operator creates for the storage pools
+	// +listType=atomic
+	StorageClasses []StorageClass `json:"storageClasses,omitempty" optional:"true"`
+	// UninstallStrategy defines what happens when the HostPathProvisioner CR is deleted while persistent volumes
+	// provisioned by the hostpath provisioner exist. Defaults to RemoveWorkloads.
+	UninstallStrategy *UninstallStrategy `json:"uninstallStrategy,omitempty" optional:"true"`
+	// StrandedVolumePolicy defines what happens to persistent volumes provisioned by the hostpath provisioner that are
+	// pinned to nodes that no longer exist. Defaults to Report.
+	StrandedVolumePolicy *StrandedVolumePolicy `json:"strandedVolumePolicy,omitempty" optional:"true"`
+	// StrandedVolumeGracePeriod is how long a persistent volume is pinned to a node that no longer exists before the
+	// stranded volume policy is applied, so the volumes of a node that is registered again are left alone. Defaults
+	// to 10m.
+	StrandedVolumeGracePeriod *metav1.Duration `json:"strandedVolumeGracePeriod,omitempty" optional:"true"`
+	// Resources are the compute resources of the containers of each component. Components without resources use the
+	// default requests.
+	Resources *ComponentResources `json:"resources,omitempty" optional:"true"`
+	// PriorityClassName is the priority class of the pods managed by the operator
+	PriorityClassName string `json:"priorityClassName,omitempty" optional:"true"`
+	// ImagePullSecrets are the secrets used to pull the images of the pods managed by the operator
+	// +listType=atomic
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" optional:"true"`
+	// Images override the images of the components, components without an image use the image configured in the
+	// operator
+	Images *ComponentImages `json:"images,omitempty" optional:"true"`
+	// LogVerbosity is the log verbosity of the operator and the components
+	LogVerbosity *LogVerbosity `json:"logVerbosity,omitempty" optional:"true"`
+}
+
+// LogVerbosity defines the log verbosity of the components. The most specific verbosity is used, a component on a
+// node uses the node component verbosity, the node default, the component verbosity, and the default in that order.
+// +k8s:openapi-gen=true
+type LogVerbosity struct {
+	// Default is the verbosity of all the components, defaults to the VERBOSITY environment variable of the operator
+	// or 3 if not set
+	// +kubebuilder:validation:Minimum=0
+	Default *int32 `json:"default,omitempty" optional:"true"`
+	// Components override the verbosity of each component
+	Components *ComponentLogVerbosity `json:"components,omitempty" optional:"true"`
+	// Nodes override the verbosity of the components on specific nodes, the CSI driver on those nodes runs in a
+	// separate DaemonSet
+	// +listType=map
+	// +listMapKey=nodeName
+	Nodes []NodeLogVerbosity `json:"nodes,omitempty" optional:"true"`
+	// Operator is the verbosity of the operator, it is changed without restarting the operator. Defaults to the level
+	// the operator was started with
+	// +kubebuilder:validation:Minimum=0
+	Operator *int32 `json:"operator,omitempty" optional:"true"`
+}
+
+// ComponentLogVerbosity defines the log verbosity of each component
+// +k8s:openapi-gen=true
+type ComponentLogVerbosity struct {
+	// Provisioner is the verbosity of the hostpath provisioner CSI driver container
+	// +kubebuilder:validation:Minimum=0
+	Provisioner *int32 `json:"provisioner,omitempty" optional:"true"`
+	// NodeDriverRegistrar is the verbosity of the node-driver-registrar container
+	// +kubebuilder:validation:Minimum=0
+	NodeDriverRegistrar *int32 `json:"nodeDriverRegistrar,omitempty" optional:"true"`
+	// CSIProvisioner is the verbosity of the csi-provisioner container
+	// +kubebuilder:validation:Minimum=0
+	CSIProvisioner *int32 `json:"csiProvisioner,omitempty" optional:"true"`
+	// CSISnapshotter is the verbosity of the csi-snapshotter container
+	// +kubebuilder:validation:Minimum=0
+	CSISnapshotter *int32 `json:"csiSnapshotter,omitempty" optional:"true"`
+	// Mounter is the verbosity of the storage pool mounter and cleanup containers, they log at the info level if no
+	// verbosity is set in the CR
+	// +kubebuilder:validation:Minimum=0
+	Mounter *int32 `json:"mounter,omitempty" optional:"true"`
+}
+
+// NodeLogVerbosity defines the log verbosity of the components on a node
+// +k8s:openapi-gen=true
+type NodeLogVerbosity struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName" valid:"required"`
+	// Default is the verbosity of all the components on the node
+	// +kubebuilder:validation:Minimum=0
+	Default *int32 `json:"default,omitempty" optional:"true"`
+	// Components override the verbosity of each component on the node
+	Components *ComponentLogVerbosity `json:"components,omitempty" optional:"true"`
+}
+
+// ComponentImages defines the container image of each component.
+// +k8s:openapi-gen=true
+type ComponentImages struct {
+	// Provisioner is the image of the hostpath provisioner CSI driver container
+	Provisioner string `json:"provisioner,omitempty" optional:"true"`
+	// LegacyProvisioner is the image of the legacy hostpath provisioner container
+	LegacyProvisioner string `json:"legacyProvisioner,omitempty" optional:"true"`
+	// NodeDriverRegistrar is the image of the node-driver-registrar container
+	NodeDriverRegistrar string `json:"nodeDriverRegistrar,omitempty" optional:"true"`
+	// LivenessProbe is the image of the liveness-probe container
+	LivenessProbe string `json:"livenessProbe,omitempty" optional:"true"`
+	// CSIProvisioner is the image of the csi-provisioner container
+	CSIProvisioner string `json:"csiProvisioner,omitempty" optional:"true"`
+	// CSISnapshotter is the image of the csi-snapshotter container
+	CSISnapshotter string `json:"csiSnapshotter,omitempty" optional:"true"`
+	// Mounter is the image of the storage pool mounter and cleanup containers
+	Mounter string `json:"mounter,omitempty" optional:"true"`
+}
+
+// ComponentResources defines the compute resources of the containers managed by the operator. If the requests of a
+// component are not set the default requests are used, lowered to the limits if those are smaller.
+// +k8s:openapi-gen=true
+type ComponentResources struct {
+	// Provisioner is the hostpath provisioner container of the DaemonSet
+	Provisioner *corev1.ResourceRequirements `json:"provisioner,omitempty" optional:"true"`
+	// NodeDriverRegistrar is the node-driver-registrar container of the CSI DaemonSet
+	NodeDriverRegistrar *corev1.ResourceRequirements `json:"nodeDriverRegistrar,omitempty" optional:"true"`
+	// LivenessProbe is the liveness-probe container of the CSI DaemonSet
+	LivenessProbe *corev1.ResourceRequirements `json:"livenessProbe,omitempty" optional:"true"`
+	// CSIProvisioner is the csi-provisioner container of the CSI DaemonSet
+	CSIProvisioner *corev1.ResourceRequirements `json:"csiProvisioner,omitempty" optional:"true"`
+	// CSISnapshotter is the csi-snapshotter container of the CSI DaemonSet, it has no default requests
+	CSISnapshotter *corev1.ResourceRequirements `json:"csiSnapshotter,omitempty" optional:"true"`
+	// Mounter is the container of the storage pool mounter Deployments
+	Mounter *corev1.ResourceRequirements `json:"mounter,omitempty" optional:"true"`
+	// Cleanup is the container of the storage pool cleanup Jobs
+	Cleanup *corev1.ResourceRequirements `json:"cleanup,omitempty" optional:"true"`
+}
+
+// UninstallStrategy defines the behavior of deleting the HostPathProvisioner CR
+// +kubebuilder:validation:Enum=RemoveWorkloads;BlockUninstallIfPVsExist
+type UninstallStrategy string
+
+const (
+	// UninstallStrategyRemoveWorkloads removes the provisioner even if persistent volumes exist
+	UninstallStrategyRemoveWorkloads UninstallStrategy = "RemoveWorkloads"
+	// UninstallStrategyBlockUninstallIfPVsExist blocks deleting the CR while persistent volumes exist
+	UninstallStrategyBlockUninstallIfPVsExist UninstallStrategy = "BlockUninstallIfPVsExist"
+)
+
+// StrandedVolumePolicy defines the handling of persistent volumes pinned to nodes that no longer exist
+// +kubebuilder:validation:Enum=Report;Release;Delete
+type StrandedVolumePolicy string
+
+const (
+	// StrandedVolumePolicyReport only reports the stranded persistent volumes
+	StrandedVolumePolicyReport StrandedVolumePolicy = "Report"
+	// StrandedVolumePolicyRelease deletes the claims of the stranded persistent volumes and retains the volumes
+	StrandedVolumePolicyRelease StrandedVolumePolicy = "Release"
+	// StrandedVolumePolicyDelete deletes the claims and the stranded persistent volumes
+	StrandedVolumePolicyDelete StrandedVolumePolicy = "Delete"
+)
+
+// HostPathProvisionerStatus defines the observed state of HostPathProvisioner
+// +k8s:openapi-gen=true
+type HostPathProvisionerStatus struct {
+	// Conditions contains the current conditions observed by the operator
+	// +listType=atomic
+	Conditions []conditions.Condition `json:"conditions,omitempty" optional:"true"`
+	// OperatorVersion The version of the HostPathProvisioner Operator
+	OperatorVersion string `json:"operatorVersion,omitempty" optional:"true"`
+	// TargetVersion The targeted version of the HostPathProvisioner deployment
+	TargetVersion string `json:"targetVersion,omitempty" optional:"true"`
+	// ObservedVersion The observed version of the HostPathProvisioner deployment
+	ObservedVersion string `json:"observedVersion,omitempty" optional:"true"`
+	// +listType=atomic
+	StoragePoolStatuses []StoragePoolStatus `json:"storagePoolStatuses,omitempty" optional:"true"`
+	// StorageClassStatuses contains the status of the storage classes managed by the operator
+	// +listType=atomic
+	StorageClassStatuses []StorageClassStatus `json:"storageClassStatuses,omitempty" optional:"true"`
+	// Images are the images the deployed components run
+	Images *ComponentImages `json:"images,omitempty" optional:"true"`
+	// CleanupFailures are the storage pools that failed to clean up on a node, they may still be mounted there. A
+	// failure is kept after its cleanup Job is deleted, until the node is gone or skips the cleanup, or a later cleanup
+	// of the storage pool on the node succeeds.
+	// +listType=atomic
+	CleanupFailures []CleanupFailure `json:"cleanupFailures,omitempty" optional:"true"`
+}
+
+// StoragePool defines how and where hostpath provisioner can use storage to create volumes.
+// +k8s:openapi-gen=true
+type StoragePool struct {
+	// Name specifies an identifier that is used in the storage class arguments to identify the source to use.
+	Name string `json:"name" valid:"required"`
+	// PVCTemplate is the template of the PVC to create as the source volume
+	PVCTemplate *corev1.PersistentVolumeClaimSpec `json:"pvcTemplate,omitempty" optional:"true"`
+	// the path to use on the host, this is a required field
+	Path string `json:"path" valid:"required"`
+	// SnapshotProvider defines the snapshot type, currently only reflink supported
+	SnapshotProvider *string `json:"snapshotProvider,omitempty" optional:"true"`
+	// OverlayClassName is used to set the name of the overlay storage class
+	OverlayClassName string `json:"overlayClassName,omitempty" optional:"true"`
+	// NodeSelector restricts the storage pool to the nodes that have each of the indicated key-value pairs as labels.
+	// The pool PVCs and mounter pods are only created on matching nodes, and the pool is only used on matching nodes.
+	// If not set the pool is available on all nodes the hostpath provisioner runs on.
+	// +kubebuilder:validation:Optional
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" optional:"true"`
+	// NearlyFullThreshold is the percentage of used capacity on a node at which the storage pool is reported as
+	// nearly full, defaults to 90
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	NearlyFullThreshold *int32 `json:"nearlyFullThreshold,omitempty" optional:"true"`
+	// Encryption encrypts the devices of a storage pool with a block volume mode PVC template using LUKS. It cannot
+	// be added to or removed from an existing storage pool.
+	Encryption *StoragePoolEncryption `json:"encryption,omitempty" optional:"true"`
+	// FSType is the filesystem the devices of a storage pool with a block volume mode PVC template are formatted
+	// with, defaults to xfs. It cannot be changed for an existing storage pool.
+	// +kubebuilder:validation:Enum=xfs;ext4
+	FSType string `json:"fsType,omitempty" optional:"true"`
+	// MkfsOptions are the extra arguments passed to mkfs when formatting the devices of a storage pool with a block
+	// volume mode PVC template. They only apply to devices that are not formatted yet.
+	// +listType=atomic
+	MkfsOptions []string `json:"mkfsOptions,omitempty" optional:"true"`
+	// MountOptions are the options the devices of a storage pool with a block volume mode PVC template are mounted
+	// with, for instance prjquota, noatime or discard.
+	// +listType=atomic
+	MountOptions []string `json:"mountOptions,omitempty" optional:"true"`
+	// Unmount configures how the storage pool is unmounted from the nodes when the pool is removed, and optionally
+	// when its mounter pod terminates.
+	Unmount *StoragePoolUnmount `json:"unmount,omitempty" optional:"true"`
+}
+
+// StoragePoolUnmount defines how a storage pool is unmounted from a node.
+// +k8s:openapi-gen=true
+type StoragePoolUnmount struct {
+	// OnTermination unmounts the storage pool from the node when its mounter pod terminates, for instance when the
+	// node is drained. By default the mount is left in place and taken over by the next mounter pod.
+	OnTermination bool `json:"onTermination,omitempty" optional:"true"`
+	// Timeout is how long to retry unmounting a busy storage pool before giving up, defaults to 5m.
+	Timeout *metav1.Duration `json:"timeout,omitempty" optional:"true"`
+	// Lazy detaches the storage pool from the node once the timeout expires while it is still busy, instead of
+	// failing. The filesystem stays in use until the processes holding it release it.
+	Lazy bool `json:"lazy,omitempty" optional:"true"`
+}
+
+// StoragePoolEncryption defines the LUKS encryption of the devices of a storage pool.
+// +k8s:openapi-gen=true
+type StoragePoolEncryption struct {
+	// SecretName is the name of the Secret in the namespace of the operator that holds the encryption key
+	SecretName string `json:"secretName" valid:"required"`
+	// Key is the key in the Secret data that holds the encryption key, defaults to key
+	Key string `json:"key,omitempty" optional:"true"`
+}
+
+const (
+	// FSTypeXFS formats the devices of a block storage pool with xfs
+	FSTypeXFS = "xfs"
+	// FSTypeExt4 formats the devices of a block storage pool with ext4
+	FSTypeExt4 = "ext4"
+)
+
+// DefaultEncryptionSecretKey is the key of the encryption key in the Secret of an encrypted storage pool
+const DefaultEncryptionSecretKey = "key"
+
+// StorageClass defines a storage class the operator creates and manages for a storage pool.
+// +k8s:openapi-gen=true
+type StorageClass struct {
+	// Name is the name of the storage class
+	Name string `json:"name" valid:"required"`
+	// StoragePool is the name of the storage pool the storage class creates volumes in
+	StoragePool string `json:"storagePool" valid:"required"`
+	// ReclaimPolicy is the reclaim policy of the volumes created by the storage class, defaults to Delete
+	// +kubebuilder:validation:Enum=Delete;Retain
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty" optional:"true"`
+	// VolumeBindingMode indicates how volumes are bound, defaults to WaitForFirstConsumer
+	// +kubebuilder:validation:Enum=Immediate;WaitForFirstConsumer
+	VolumeBindingMode *storagev1.VolumeBindingMode `json:"volumeBindingMode,omitempty" optional:"true"`
+	// AllowVolumeExpansion shows whether the storage class allows volume expand
+	AllowVolumeExpansion *bool `json:"allowVolumeExpansion,omitempty" optional:"true"`
+	// Default marks the storage class as the default storage class of the cluster
+	Default bool `json:"default,omitempty" optional:"true"`
+}
+
+// StorageClassStatus is the status of an operator managed storage class
+type StorageClassStatus struct {
+	// Name is the name of the storage class
+	Name string `json:"name" valid:"required"`
+	// StoragePool is the name of the storage pool used by the storage class
+	StoragePool string `json:"storagePool" valid:"required"`
+	// Ready indicates the storage class exists and its storage pool is ready
+	Ready bool `json:"ready"`
+	// Message explains why the storage class is not ready
+	Message string `json:"message,omitempty" optional:"true"`
+}
+
+// StoragePoolStatus is the status of the named storage pool
+type StoragePoolStatus struct {
+	// Name is the name of the storage pool
+	Name string `json:"name" valid:"required"`
+	// StoragePoolPhase indicates which phase the storage pool is in.
+	Phase StoragePoolPhase `json:"phase" valid:"required"`
+	// Reason is a machine readable reason the storage pool is not ready, taken from the first node it is not ready on
+	Reason string `json:"reason,omitempty" optional:"true"`
+	// Message describes why the storage pool is not ready
+	Message string `json:"message,omitempty" optional:"true"`
+	// DesiredReady is the number of desired ready replicasets.
+	DesiredReady int `json:"desiredReady,omitempty" optional:"true"`
+	// CurrentReady is the number of currently ready replicasets.
+	CurrentReady int `json:"currentReady,omitempty" optional:"true"`
+	// The status of all the claims. Deprecated, no longer set, the claim of each node is reported in the
+	// NodeStoragePool of the node.
+	// +listType=atomic
+	ClaimStatuses []ClaimStatus `json:"claimStatuses,omitempty" optional:"true"`
+	// Capacity is the total size of the storage pool on all nodes, as published by the CSI driver. The capacity of
+	// each node is reported in the NodeStoragePool of the node.
+	Capacity *resource.Quantity `json:"capacity,omitempty" optional:"true"`
+	// Allocatable is the capacity that is still available for new volumes on all nodes
+	Allocatable *resource.Quantity `json:"allocatable,omitempty" optional:"true"`
+	// Used is the capacity that is in use on all nodes
+	Used *resource.Quantity `json:"used,omitempty" optional:"true"`
+	// Encrypted indicates the devices of the storage pool are encrypted
+	Encrypted bool `json:"encrypted,omitempty" optional:"true"`
+	// Conditions contains the conditions of the storage pool
+	// +listType=atomic
+	Conditions []metav1.Condition `json:"conditions,omitempty" optional:"true"`
+}
+
+// ClaimStatus defines the storage claim status for each PVC in a storage pool
+type ClaimStatus struct {
+	// Name of the PersistentVolumeClaim
+	Name string `json:"name" valid:"required"`
+	// Status of the PersistentVolumeClaim
+	Status corev1.PersistentVolumeClaimStatus `json:"status" valid:"required"`
+}
+
+// CleanupFailure is a storage pool cleanup Job that failed on a node
+type CleanupFailure struct {
+	// StoragePool is the name of the storage pool
+	StoragePool string `json:"storagePool" valid:"required"`
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName" valid:"required"`
+	// Message is the reason the cleanup Job failed
+	Message string `json:"message" valid:"required"`
+}
+
+// StoragePoolPhase is the current phase of the storage pool.
+type StoragePoolPhase string
+
+const (
+	// StoragePoolPreparing indicates the storage pool is preparing one or more volumes for use.
+	StoragePoolPreparing StoragePoolPhase = "Preparing"
+	//StoragePoolMounting indicates one or more pool are in the process of mounting.
+	StoragePoolMounting StoragePoolPhase = "Mounting"
+	// StoragePoolReady indicates all the volumes are ready for use.
+	StoragePoolReady StoragePoolPhase = "Ready"
+	// StoragePoolFailed indicates the storage pool failed on one or more nodes and needs attention, the reason tells
+	// why.
+	StoragePoolFailed StoragePoolPhase = "Failed"
+)
+
+const (
+	// StoragePoolNearlyFull indicates the used capacity of the storage pool crossed the nearly full threshold on one
+	// or more nodes.
+	StoragePoolNearlyFull = "NearlyFull"
+	// StoragePoolExpanding indicates the PVCs of the storage pool are being expanded to the size of the PVC template
+	// on one or more nodes.
+	StoragePoolExpanding = "Expanding"
+	// DefaultNearlyFullThreshold is the default percentage of used capacity at which a storage pool is nearly full
+	DefaultNearlyFullThreshold int32 = 90
+)
+
+// this has to be here otherwise informer-gen doesn't recognize it
+// see https://github.com/kubernetes/code-generator/issues/59
+// +genclient:nonNamespaced
+
+// HostPathProvisioner is the Schema for the hostpathprovisioners API
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=hostpathprovisioners,scope=Cluster
+type HostPathProvisioner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HostPathProvisionerSpec   `json:"spec,omitempty"`
+	Status HostPathProvisionerStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HostPathProvisionerList contains a list of HostPathProvisioner
+type HostPathProvisionerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostPathProvisioner `json:"items"`
+}
+
+// NodeStoragePoolSpec identifies the node and storage pool of a NodeStoragePool
+type NodeStoragePoolSpec struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName" valid:"required"`
+	// StoragePoolName is the name of the storage pool
+	StoragePoolName string `json:"storagePoolName" valid:"required"`
+}
+
+// NodeStoragePoolStatus is the state of a storage pool on a single node
+type NodeStoragePoolStatus struct {
+	// Phase indicates which phase the storage pool is in on the node.
+	Phase StoragePoolPhase `json:"phase,omitempty" optional:"true"`
+	// Mounted indicates the storage pool is mounted on the node. Storage pools without a PVC template are always
+	// mounted.
+	Mounted bool `json:"mounted"`
+	// ClaimName is the name of the PersistentVolumeClaim backing the storage pool on the node
+	ClaimName string `json:"claimName,omitempty" optional:"true"`
+	// ClaimPhase is the phase of the PersistentVolumeClaim backing the storage pool on the node
+	ClaimPhase corev1.PersistentVolumeClaimPhase `json:"claimPhase,omitempty" optional:"true"`
+	// MounterPod is the name of the pod that mounts the storage pool on the node
+	MounterPod string `json:"mounterPod,omitempty" optional:"true"`
+	// Capacity is the total size of the storage pool on the node, as published by the CSI driver
+	Capacity *resource.Quantity `json:"capacity,omitempty" optional:"true"`
+	// Allocatable is the capacity that is still available for new volumes on the node
+	Allocatable *resource.Quantity `json:"allocatable,omitempty" optional:"true"`
+	// Used is the capacity that is in use on the node
+	Used *resource.Quantity `json:"used,omitempty" optional:"true"`
+	// Encrypted indicates the device of the storage pool is encrypted and opened on the node
+	Encrypted bool `json:"encrypted,omitempty" optional:"true"`
+	// ClaimCapacity is the size of the PersistentVolumeClaim backing the storage pool on the node
+	ClaimCapacity *resource.Quantity `json:"claimCapacity,omitempty" optional:"true"`
+	// Expansion indicates the state of the expansion of the PersistentVolumeClaim backing the storage pool on the
+	// node to the size of the PVC template. It is empty if the claim has the size of the template.
+	Expansion StoragePoolExpansionPhase `json:"expansion,omitempty" optional:"true"`
+	// Reason is a machine readable reason the storage pool is not ready on the node
+	Reason string `json:"reason,omitempty" optional:"true"`
+	// LastError is the last error that kept the storage pool from becoming ready on the node
+	LastError string `json:"lastError,omitempty" optional:"true"`
+}
+
+// StoragePoolExpansionPhase is the state of the expansion of a storage pool PVC.
+type StoragePoolExpansionPhase string
+
+const (
+	// StoragePoolExpansionInProgress indicates the PVC and the filesystem on it are being expanded.
+	StoragePoolExpansionInProgress StoragePoolExpansionPhase = "InProgress"
+	// StoragePoolExpansionNotSupported indicates the storage class of the PVC doesn't allow volume expansion.
+	StoragePoolExpansionNotSupported StoragePoolExpansionPhase = "NotSupported"
+	// StoragePoolExpansionFailed indicates the storage provider failed to expand the PVC.
+	StoragePoolExpansionFailed StoragePoolExpansionPhase = "Failed"
+)
+
+// NodeStoragePool reports the state of a storage pool on a single node. The operator creates one for each node and
+// storage pool, and overwrites any changes made to it.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=nodestoragepools,scope=Cluster,shortName=nsp
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.spec.nodeName`
+// +kubebuilder:printcolumn:name="Pool",type=string,JSONPath=`.spec.storagePoolName`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Capacity",type=string,JSONPath=`.status.capacity`
+// +kubebuilder:printcolumn:name="Used",type=string,JSONPath=`.status.used`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type NodeStoragePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeStoragePoolSpec   `json:"spec,omitempty"`
+	Status NodeStoragePoolStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeStoragePoolList contains a list of NodeStoragePool
+type NodeStoragePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeStoragePool `json:"items"`
+}
+
+// NodePlacement describes node scheduling configuration.
+// +k8s:openapi-gen=true
+type NodePlacement struct {
+	// nodeSelector is the node selector applied to the relevant kind of pods
+	// It specifies a map of key-value pairs: for the pod to be eligible to run on a node,
+	// the node must have each of the indicated key-value pairs as labels
+	// (it can have additional labels as well).
+	// See https://kubernetes.io/docs/concepts/configuration/assign-pod-node/#nodeselector
+	// +kubebuilder:validation:Optional
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// affinity enables pod affinity/anti-affinity placement expanding the types of constraints
+	// that can be expressed with nodeSelector.
+	// affinity is going to be applied to the relevant kind of pods in parallel with nodeSelector
+	// See https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#affinity-and-anti-affinity
+	// +kubebuilder:validation:Optional
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// tolerations is a list of tolerations applied to the relevant kind of pods
+	// See https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/ for more info.
+	// These are additional tolerations other than default ones.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
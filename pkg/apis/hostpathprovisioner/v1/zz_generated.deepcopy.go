@@ -0,0 +1,747 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimStatus) DeepCopyInto(out *ClaimStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimStatus.
+func (in *ClaimStatus) DeepCopy() *ClaimStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupFailure) DeepCopyInto(out *CleanupFailure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupFailure.
+func (in *CleanupFailure) DeepCopy() *CleanupFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentImages) DeepCopyInto(out *ComponentImages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImages.
+func (in *ComponentImages) DeepCopy() *ComponentImages {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentLogVerbosity) DeepCopyInto(out *ComponentLogVerbosity) {
+	*out = *in
+	if in.Provisioner != nil {
+		in, out := &in.Provisioner, &out.Provisioner
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeDriverRegistrar != nil {
+		in, out := &in.NodeDriverRegistrar, &out.NodeDriverRegistrar
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CSIProvisioner != nil {
+		in, out := &in.CSIProvisioner, &out.CSIProvisioner
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CSISnapshotter != nil {
+		in, out := &in.CSISnapshotter, &out.CSISnapshotter
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Mounter != nil {
+		in, out := &in.Mounter, &out.Mounter
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentLogVerbosity.
+func (in *ComponentLogVerbosity) DeepCopy() *ComponentLogVerbosity {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentLogVerbosity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentResources) DeepCopyInto(out *ComponentResources) {
+	*out = *in
+	if in.Provisioner != nil {
+		in, out := &in.Provisioner, &out.Provisioner
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeDriverRegistrar != nil {
+		in, out := &in.NodeDriverRegistrar, &out.NodeDriverRegistrar
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSIProvisioner != nil {
+		in, out := &in.CSIProvisioner, &out.CSIProvisioner
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSISnapshotter != nil {
+		in, out := &in.CSISnapshotter, &out.CSISnapshotter
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mounter != nil {
+		in, out := &in.Mounter, &out.Mounter
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentResources.
+func (in *ComponentResources) DeepCopy() *ComponentResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisioner) DeepCopyInto(out *HostPathProvisioner) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisioner.
+func (in *HostPathProvisioner) DeepCopy() *HostPathProvisioner {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisioner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostPathProvisioner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerList) DeepCopyInto(out *HostPathProvisionerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HostPathProvisioner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerList.
+func (in *HostPathProvisionerList) DeepCopy() *HostPathProvisionerList {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostPathProvisionerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerSpec) DeepCopyInto(out *HostPathProvisionerSpec) {
+	*out = *in
+	in.Workload.DeepCopyInto(&out.Workload)
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StoragePools != nil {
+		in, out := &in.StoragePools, &out.StoragePools
+		*out = make([]StoragePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UninstallStrategy != nil {
+		in, out := &in.UninstallStrategy, &out.UninstallStrategy
+		*out = new(UninstallStrategy)
+		**out = **in
+	}
+	if in.StrandedVolumePolicy != nil {
+		in, out := &in.StrandedVolumePolicy, &out.StrandedVolumePolicy
+		*out = new(StrandedVolumePolicy)
+		**out = **in
+	}
+	if in.StrandedVolumeGracePeriod != nil {
+		in, out := &in.StrandedVolumeGracePeriod, &out.StrandedVolumeGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ComponentResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ComponentImages)
+		**out = **in
+	}
+	if in.LogVerbosity != nil {
+		in, out := &in.LogVerbosity, &out.LogVerbosity
+		*out = new(LogVerbosity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerSpec.
+func (in *HostPathProvisionerSpec) DeepCopy() *HostPathProvisionerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerStatus) DeepCopyInto(out *HostPathProvisionerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]conditionsv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StoragePoolStatuses != nil {
+		in, out := &in.StoragePoolStatuses, &out.StoragePoolStatuses
+		*out = make([]StoragePoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageClassStatuses != nil {
+		in, out := &in.StorageClassStatuses, &out.StorageClassStatuses
+		*out = make([]StorageClassStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ComponentImages)
+		**out = **in
+	}
+	if in.CleanupFailures != nil {
+		in, out := &in.CleanupFailures, &out.CleanupFailures
+		*out = make([]CleanupFailure, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerStatus.
+func (in *HostPathProvisionerStatus) DeepCopy() *HostPathProvisionerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogVerbosity) DeepCopyInto(out *LogVerbosity) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(ComponentLogVerbosity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeLogVerbosity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogVerbosity.
+func (in *LogVerbosity) DeepCopy() *LogVerbosity {
+	if in == nil {
+		return nil
+	}
+	out := new(LogVerbosity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLogVerbosity) DeepCopyInto(out *NodeLogVerbosity) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(ComponentLogVerbosity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLogVerbosity.
+func (in *NodeLogVerbosity) DeepCopy() *NodeLogVerbosity {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLogVerbosity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePlacement) DeepCopyInto(out *NodePlacement) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePlacement.
+func (in *NodePlacement) DeepCopy() *NodePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStoragePool) DeepCopyInto(out *NodeStoragePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStoragePool.
+func (in *NodeStoragePool) DeepCopy() *NodeStoragePool {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStoragePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeStoragePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStoragePoolList) DeepCopyInto(out *NodeStoragePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeStoragePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStoragePoolList.
+func (in *NodeStoragePoolList) DeepCopy() *NodeStoragePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStoragePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeStoragePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStoragePoolSpec) DeepCopyInto(out *NodeStoragePoolSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStoragePoolSpec.
+func (in *NodeStoragePoolSpec) DeepCopy() *NodeStoragePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStoragePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStoragePoolStatus) DeepCopyInto(out *NodeStoragePoolStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ClaimCapacity != nil {
+		in, out := &in.ClaimCapacity, &out.ClaimCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStoragePoolStatus.
+func (in *NodeStoragePoolStatus) DeepCopy() *NodeStoragePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStoragePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(corev1.PersistentVolumeReclaimPolicy)
+		**out = **in
+	}
+	if in.VolumeBindingMode != nil {
+		in, out := &in.VolumeBindingMode, &out.VolumeBindingMode
+		*out = new(storagev1.VolumeBindingMode)
+		**out = **in
+	}
+	if in.AllowVolumeExpansion != nil {
+		in, out := &in.AllowVolumeExpansion, &out.AllowVolumeExpansion
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassStatus) DeepCopyInto(out *StorageClassStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassStatus.
+func (in *StorageClassStatus) DeepCopy() *StorageClassStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoragePool) DeepCopyInto(out *StoragePool) {
+	*out = *in
+	if in.PVCTemplate != nil {
+		in, out := &in.PVCTemplate, &out.PVCTemplate
+		*out = new(corev1.PersistentVolumeClaimSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotProvider != nil {
+		in, out := &in.SnapshotProvider, &out.SnapshotProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NearlyFullThreshold != nil {
+		in, out := &in.NearlyFullThreshold, &out.NearlyFullThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(StoragePoolEncryption)
+		**out = **in
+	}
+	if in.MkfsOptions != nil {
+		in, out := &in.MkfsOptions, &out.MkfsOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MountOptions != nil {
+		in, out := &in.MountOptions, &out.MountOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unmount != nil {
+		in, out := &in.Unmount, &out.Unmount
+		*out = new(StoragePoolUnmount)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoragePool.
+func (in *StoragePool) DeepCopy() *StoragePool {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoragePoolEncryption) DeepCopyInto(out *StoragePoolEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoragePoolEncryption.
+func (in *StoragePoolEncryption) DeepCopy() *StoragePoolEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoragePoolStatus) DeepCopyInto(out *StoragePoolStatus) {
+	*out = *in
+	if in.ClaimStatuses != nil {
+		in, out := &in.ClaimStatuses, &out.ClaimStatuses
+		*out = make([]ClaimStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoragePoolStatus.
+func (in *StoragePoolStatus) DeepCopy() *StoragePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoragePoolUnmount) DeepCopyInto(out *StoragePoolUnmount) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoragePoolUnmount.
+func (in *StoragePoolUnmount) DeepCopy() *StoragePoolUnmount {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolUnmount)
+	in.DeepCopyInto(out)
+	return out
+}
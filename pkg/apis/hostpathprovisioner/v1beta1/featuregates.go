@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// SnapshottingFeatureGate enables volume snapshots for storage pools that have a snapshot provider
+	SnapshottingFeatureGate = "Snapshotting"
+	// ReflinkSnapshotProvider creates snapshots using reflinks, the storage pool file system must support them
+	ReflinkSnapshotProvider = "reflink"
+
+	// maxSuggestionDistance is the maximum edit distance between an unknown value and a known value to suggest it
+	maxSuggestionDistance = 2
+)
+
+var (
+	// knownFeatureGates maps the feature gates the operator understands to a deprecation message. Deprecated
+	// feature gates are still accepted, but produce an admission warning.
+	knownFeatureGates = map[string]string{
+		SnapshottingFeatureGate: "",
+	}
+	// knownSnapshotProviders are the supported values of storagePool.snapshotProvider
+	knownSnapshotProviders = []string{
+		ReflinkSnapshotProvider,
+	}
+)
+
+// KnownFeatureGates returns the sorted names of the feature gates the operator understands
+func KnownFeatureGates() []string {
+	res := make([]string, 0, len(knownFeatureGates))
+	for name := range knownFeatureGates {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func validateFeatureGates(featureGates []string) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	for i, featureGate := range featureGates {
+		deprecation, ok := knownFeatureGates[featureGate]
+		if !ok {
+			return nil, unknownValueError(fmt.Sprintf("spec.featureGates[%d]", i), featureGate, "feature gate", KnownFeatureGates())
+		}
+		if deprecation != "" {
+			warnings = append(warnings, fmt.Sprintf("spec.featureGates[%d] %q is deprecated: %s", i, featureGate, deprecation))
+		}
+	}
+	return warnings, nil
+}
+
+func validateSnapshotProvider(snapshotProvider *string) error {
+	if snapshotProvider == nil {
+		return nil
+	}
+	for _, known := range knownSnapshotProviders {
+		if *snapshotProvider == known {
+			return nil
+		}
+	}
+	return unknownValueError("storagePool.snapshotProvider", *snapshotProvider, "snapshot provider", knownSnapshotProviders)
+}
+
+func unknownValueError(fieldPath, value, kind string, known []string) error {
+	if suggestion := closestMatch(value, known); suggestion != "" {
+		return fmt.Errorf("%s %q is not a known %s, did you mean %q?", fieldPath, value, kind, suggestion)
+	}
+	return fmt.Errorf("%s %q is not a known %s, must be one of: %s", fieldPath, value, kind, strings.Join(known, ", "))
+}
+
+// closestMatch returns the known value that is closest to value, or an empty string if none is close enough.
+func closestMatch(value string, known []string) string {
+	best := ""
+	bestDistance := maxSuggestionDistance + 1
+	for _, candidate := range known {
+		if distance := editDistance(strings.ToLower(value), strings.ToLower(candidate)); distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
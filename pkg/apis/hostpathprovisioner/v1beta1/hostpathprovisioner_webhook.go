@@ -19,70 +19,526 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"path"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
 )
 
 const (
 	maxStoragePoolNameLength = 50
 	maxPathLength            = 255
+	// legacyStoragePoolName is the name of the storage pool created from the pathConfig
+	legacyStoragePoolName = "legacy"
+	// DefaultOverlayClassName is the name of the overlay storage class of a shared pool if not set in the pool
+	DefaultOverlayClassName = "hpp-overlay"
+	// ForceUpdateAnnotation allows updates that strand the data of existing volumes when set to "true" on the CR
+	ForceUpdateAnnotation = "hostpathprovisioner.kubevirt.io/force-update"
+	// storagePoolVolumeAttribute is the CSI volume attribute that contains the storage pool of a volume
+	storagePoolVolumeAttribute = "storagePool"
 )
 
+// denylistedPaths are host paths that storage pools cannot use, be nested in, or contain.
+var denylistedPaths = []string{
+	"/",
+	"/bin",
+	"/boot",
+	"/dev",
+	"/etc",
+	"/lib",
+	"/lib64",
+	"/proc",
+	"/run",
+	"/sbin",
+	"/sys",
+	"/usr",
+	"/var/lib/kubelet",
+	"/var/lib/containers",
+	"/var/lib/containerd",
+	"/var/run",
+}
+
 // SetupWebhookWithManager configures the webhook for the passed in manager
 func (r *HostPathProvisioner) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, r).
-		WithValidator(&HostPathProvisionerValidator{}).
+		WithDefaulter(&HostPathProvisionerDefaulter{}).
+		WithValidator(&HostPathProvisionerValidator{Client: mgr.GetAPIReader()}).
 		Complete()
 }
 
+// HostPathProvisionerDefaulter sets the defaults of HostPathProvisioner create and update requests, so the stored CR
+// shows the values the operator uses
+// +k8s:deepcopy-gen=false
+type HostPathProvisionerDefaulter struct {
+}
+
+var _ admission.Defaulter[*HostPathProvisioner] = &HostPathProvisionerDefaulter{}
+
+// Default implements admission.Defaulter so a webhook will be registered for the type
+func (d *HostPathProvisionerDefaulter) Default(ctx context.Context, obj *HostPathProvisioner) error {
+	if obj.Spec.ImagePullPolicy == "" {
+		obj.Spec.ImagePullPolicy = corev1.PullIfNotPresent
+	}
+	if obj.Spec.UninstallStrategy == nil {
+		strategy := UninstallStrategyRemoveWorkloads
+		obj.Spec.UninstallStrategy = &strategy
+	}
+	if obj.Spec.StrandedVolumePolicy == nil {
+		policy := StrandedVolumePolicyReport
+		obj.Spec.StrandedVolumePolicy = &policy
+	}
+	for i := range obj.Spec.StoragePools {
+		storagePool := &obj.Spec.StoragePools[i]
+		if storagePool.NearlyFullThreshold == nil {
+			threshold := DefaultNearlyFullThreshold
+			storagePool.NearlyFullThreshold = &threshold
+		}
+		if storagePool.PVCTemplate == nil {
+			continue
+		}
+		if len(storagePool.PVCTemplate.AccessModes) == 0 {
+			storagePool.PVCTemplate.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+		}
+		if storagePool.PVCTemplate.VolumeMode == nil {
+			volumeMode := corev1.PersistentVolumeFilesystem
+			storagePool.PVCTemplate.VolumeMode = &volumeMode
+		}
+		if isSharedStoragePool(*storagePool) && storagePool.OverlayClassName == "" {
+			storagePool.OverlayClassName = DefaultOverlayClassName
+		}
+	}
+	return nil
+}
+
+// HostPathProvisionerValidator validates HostPathProvisioner create and update requests
+// +k8s:deepcopy-gen=false
 type HostPathProvisionerValidator struct {
+	// Client is used to look up the volumes of the storage pools, the lookups are skipped if it is not set
+	Client client.Reader
 }
 
 var _ admission.Validator[*HostPathProvisioner] = &HostPathProvisionerValidator{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (v *HostPathProvisionerValidator) ValidateCreate(ctx context.Context, obj *HostPathProvisioner) (warnings admission.Warnings, err error) {
-	return v.validatePathConfigAndStoragePools(obj)
+	return v.validatePathConfigAndStoragePools(nil, obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (v *HostPathProvisionerValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *HostPathProvisioner) (warnings admission.Warnings, err error) {
-	return v.validatePathConfigAndStoragePools(newObj)
+	if newObj.GetDeletionTimestamp() != nil {
+		// The operator removes its finalizer once the CR is deleted, the spec no longer matters
+		return nil, nil
+	}
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		// The CRD has no status subresource, status and metadata updates go through here as well
+		return nil, nil
+	}
+	warnings, err = v.validatePathConfigAndStoragePools(oldObj, newObj)
+	if err != nil {
+		return nil, err
+	}
+	if newObj.GetAnnotations()[ForceUpdateAnnotation] == "true" {
+		return append(warnings, fmt.Sprintf("%s is set, storage pool changes that strand existing volumes are allowed", ForceUpdateAnnotation)), nil
+	}
+	if err := v.validateStoragePoolTransitions(ctx, oldObj, newObj); err != nil {
+		return nil, err
+	}
+	return warnings, nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (v *HostPathProvisionerValidator) ValidateDelete(ctx context.Context, obj *HostPathProvisioner) (warnings admission.Warnings, err error) {
+	if obj.Spec.UninstallStrategy == nil || *obj.Spec.UninstallStrategy != UninstallStrategyBlockUninstallIfPVsExist || v.Client == nil {
+		return nil, nil
+	}
+	count, err := util.CountHostPathProvisionerVolumes(ctx, v.Client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list persistent volumes: %v", err)
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("cannot delete the hostpath provisioner, the uninstall strategy is %s and %d persistent volumes exist", UninstallStrategyBlockUninstallIfPVsExist, count)
+	}
 	return nil, nil
 }
 
-func (v *HostPathProvisionerValidator) validatePathConfigAndStoragePools(hpp *HostPathProvisioner) (admission.Warnings, error) {
-	if hpp.Spec.PathConfig != nil && len(hpp.Spec.StoragePools) > 0 {
-		return nil, fmt.Errorf("pathConfig and storage pools cannot be both set")
-	} else if hpp.Spec.PathConfig == nil && len(hpp.Spec.StoragePools) == 0 {
-		return nil, fmt.Errorf("either pathConfig or storage pools must be set")
+// validatePathConfigAndStoragePools validates the spec of the CR. On update oldHpp is the current CR, and only the fields
+// that changed are validated, so a CR that was accepted by an older version of the operator can still be updated.
+func (v *HostPathProvisionerValidator) validatePathConfigAndStoragePools(oldHpp, hpp *HostPathProvisioner) (admission.Warnings, error) {
+	oldSpec := &HostPathProvisionerSpec{}
+	if oldHpp != nil {
+		oldSpec = &oldHpp.Spec
 	}
-	if hpp.Spec.PathConfig != nil && len(hpp.Spec.PathConfig.Path) == 0 {
-		return nil, fmt.Errorf("pathconfig path must be set")
+	changed := func(oldField, newField interface{}) bool {
+		return oldHpp == nil || !equality.Semantic.DeepEqual(oldField, newField)
+	}
+	oldPools := make(map[string]StoragePool)
+	for _, storagePool := range oldSpec.StoragePools {
+		oldPools[storagePool.Name] = storagePool
+	}
+	poolChanged := func(storagePool StoragePool) bool {
+		oldPool, ok := oldPools[storagePool.Name]
+		return !ok || changed(oldPool, storagePool)
+	}
+	poolsChanged := changed(oldSpec.PathConfig, hpp.Spec.PathConfig) || changed(oldSpec.StoragePools, hpp.Spec.StoragePools)
+	if poolsChanged {
+		if err := validatePathConfig(hpp); err != nil {
+			return nil, err
+		}
+	}
+	var warnings admission.Warnings
+	if changed(oldSpec.FeatureGates, hpp.Spec.FeatureGates) {
+		var err error
+		if warnings, err = validateFeatureGates(hpp.Spec.FeatureGates); err != nil {
+			return nil, err
+		}
 	}
 	usedPaths := make(map[string]int, 0)
 	usedNames := make(map[string]int, 0)
 	for i, source := range hpp.Spec.StoragePools {
-		if err := validateStoragePool(source); err != nil {
-			return nil, err
+		if poolChanged(source) {
+			if err := validateStoragePool(source); err != nil {
+				return nil, err
+			}
 		}
 		if index, ok := usedPaths[source.Path]; !ok {
 			usedPaths[source.Path] = i
-		} else {
+		} else if poolChanged(source) || poolChanged(hpp.Spec.StoragePools[index]) {
 			return nil, fmt.Errorf("spec.storagePools[%d].path is the same as spec.storagePools[%d].path, cannot have duplicate paths", i, index)
 		}
+		for j := 0; j < i; j++ {
+			if !poolChanged(source) && !poolChanged(hpp.Spec.StoragePools[j]) {
+				continue
+			}
+			if err := validateStoragePoolPathsDisjoint(hpp.Spec.StoragePools[j], j, source, i); err != nil {
+				return nil, err
+			}
+		}
 		if index, ok := usedNames[source.Name]; !ok {
 			usedNames[source.Name] = i
 		} else {
 			return nil, fmt.Errorf("spec.storagePools[%d].name is the same as spec.storagePools[%d].name, cannot have duplicate names", i, index)
 		}
 	}
-	return nil, nil
+	// The storage classes reference the storage pools by name
+	if poolsChanged || changed(oldSpec.StorageClasses, hpp.Spec.StorageClasses) {
+		if err := validateStorageClasses(hpp); err != nil {
+			return nil, err
+		}
+	}
+	if changed(oldSpec.Resources, hpp.Spec.Resources) {
+		if err := validateComponentResources(hpp.Spec.Resources); err != nil {
+			return nil, err
+		}
+	}
+	if changed(oldSpec.PriorityClassName, hpp.Spec.PriorityClassName) || changed(oldSpec.ImagePullSecrets, hpp.Spec.ImagePullSecrets) || changed(oldSpec.Images, hpp.Spec.Images) {
+		if err := validatePodSettings(hpp); err != nil {
+			return nil, err
+		}
+	}
+	if changed(oldSpec.StrandedVolumeGracePeriod, hpp.Spec.StrandedVolumeGracePeriod) {
+		if gracePeriod := hpp.Spec.StrandedVolumeGracePeriod; gracePeriod != nil && gracePeriod.Duration <= 0 {
+			return nil, fmt.Errorf("spec.strandedVolumeGracePeriod must be positive")
+		}
+	}
+	if changed(oldSpec.LogVerbosity, hpp.Spec.LogVerbosity) {
+		if err := validateLogVerbosity(hpp.Spec.LogVerbosity); err != nil {
+			return nil, err
+		}
+	}
+	return warnings, nil
+}
+
+func validatePathConfig(hpp *HostPathProvisioner) error {
+	if hpp.Spec.PathConfig != nil && len(hpp.Spec.StoragePools) > 0 {
+		return fmt.Errorf("pathConfig and storage pools cannot be both set")
+	} else if hpp.Spec.PathConfig == nil && len(hpp.Spec.StoragePools) == 0 {
+		return fmt.Errorf("either pathConfig or storage pools must be set")
+	}
+	if hpp.Spec.PathConfig != nil && len(hpp.Spec.PathConfig.Path) == 0 {
+		return fmt.Errorf("pathconfig path must be set")
+	}
+	return nil
+}
+
+// validateStoragePoolTransitions rejects storage pool changes that strand the data of the existing volumes. The path and
+// volume mode of a storage pool cannot change, its pvcTemplate cannot be added or removed, a storage pool cannot be
+// renamed, and it cannot be removed while bound persistent volumes use it.
+func (v *HostPathProvisionerValidator) validateStoragePoolTransitions(ctx context.Context, oldHpp, newHpp *HostPathProvisioner) error {
+	oldPools := make(map[string]StoragePool)
+	for _, storagePool := range oldHpp.Spec.StoragePools {
+		oldPools[storagePool.Name] = storagePool
+	}
+	newPools := make(map[string]StoragePool)
+	newPoolsByPath := make(map[string]string)
+	for i, storagePool := range newHpp.Spec.StoragePools {
+		newPools[storagePool.Name] = storagePool
+		newPoolsByPath[path.Clean(storagePool.Path)] = storagePool.Name
+		oldPool, ok := oldPools[storagePool.Name]
+		if !ok {
+			continue
+		}
+		if path.Clean(oldPool.Path) != path.Clean(storagePool.Path) {
+			return fmt.Errorf("spec.storagePools[%d].path cannot be changed from %q to %q, the volumes of storage pool %q would be stranded", i, oldPool.Path, storagePool.Path, storagePool.Name)
+		}
+		if (oldPool.PVCTemplate == nil) != (storagePool.PVCTemplate == nil) {
+			return fmt.Errorf("spec.storagePools[%d].pvcTemplate cannot be added or removed, the volumes of storage pool %q would be stranded", i, storagePool.Name)
+		}
+		if oldPool.PVCTemplate != nil && storagePool.PVCTemplate != nil {
+			oldMode, newMode := getVolumeMode(oldPool.PVCTemplate), getVolumeMode(storagePool.PVCTemplate)
+			if oldMode != newMode {
+				return fmt.Errorf("spec.storagePools[%d].pvcTemplate.volumeMode cannot be changed from %s to %s, the volumes of storage pool %q would be stranded", i, oldMode, newMode, storagePool.Name)
+			}
+		}
+		if oldPool.PVCTemplate != nil && getFSType(oldPool) != getFSType(storagePool) {
+			return fmt.Errorf("spec.storagePools[%d].fsType cannot be changed from %s to %s, the devices of storage pool %q are already formatted", i, getFSType(oldPool), getFSType(storagePool), storagePool.Name)
+		}
+		if (oldPool.Encryption == nil) != (storagePool.Encryption == nil) {
+			return fmt.Errorf("spec.storagePools[%d].encryption cannot be added or removed, the devices of storage pool %q are already formatted", i, storagePool.Name)
+		}
+	}
+	for _, oldPool := range oldHpp.Spec.StoragePools {
+		if _, ok := newPools[oldPool.Name]; ok {
+			continue
+		}
+		if newName, ok := newPoolsByPath[path.Clean(oldPool.Path)]; ok {
+			if _, existed := oldPools[newName]; !existed {
+				return fmt.Errorf("storage pool %q cannot be renamed to %q, the volumes of the storage pool would be stranded", oldPool.Name, newName)
+			}
+		}
+		count, err := v.countBoundVolumes(ctx, oldPool.Name)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("storage pool %q cannot be removed, it is used by %d bound persistent volumes", oldPool.Name, count)
+		}
+	}
+	return nil
+}
+
+// countBoundVolumes returns the number of bound persistent volumes in the storage pool, or 0 if there is no client.
+func (v *HostPathProvisionerValidator) countBoundVolumes(ctx context.Context, storagePoolName string) (int, error) {
+	if v.Client == nil {
+		return 0, nil
+	}
+	pvList := &corev1.PersistentVolumeList{}
+	if err := v.Client.List(ctx, pvList); err != nil {
+		return 0, fmt.Errorf("unable to list persistent volumes of storage pool %q: %v", storagePoolName, err)
+	}
+	count := 0
+	for _, pv := range pvList.Items {
+		if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != util.CSIDriverName || pv.Status.Phase != corev1.VolumeBound {
+			continue
+		}
+		if pv.Spec.CSI.VolumeAttributes[storagePoolVolumeAttribute] == storagePoolName {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func getVolumeMode(pvcTemplate *corev1.PersistentVolumeClaimSpec) corev1.PersistentVolumeMode {
+	if pvcTemplate.VolumeMode == nil {
+		return corev1.PersistentVolumeFilesystem
+	}
+	return *pvcTemplate.VolumeMode
+}
+
+func validateStorageClasses(hpp *HostPathProvisioner) error {
+	poolNames := make(map[string]struct{})
+	if hpp.Spec.PathConfig != nil {
+		poolNames[legacyStoragePoolName] = struct{}{}
+	}
+	for _, storagePool := range hpp.Spec.StoragePools {
+		poolNames[storagePool.Name] = struct{}{}
+	}
+	usedNames := make(map[string]int, 0)
+	defaultIndex := -1
+	for i, storageClass := range hpp.Spec.StorageClasses {
+		if errs := validation.IsDNS1123Subdomain(storageClass.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.storageClasses[%d].name %q is invalid: %s", i, storageClass.Name, strings.Join(errs, ", "))
+		}
+		if index, ok := usedNames[storageClass.Name]; ok {
+			return fmt.Errorf("spec.storageClasses[%d].name is the same as spec.storageClasses[%d].name, cannot have duplicate names", i, index)
+		}
+		usedNames[storageClass.Name] = i
+		if _, ok := poolNames[storageClass.StoragePool]; !ok {
+			return fmt.Errorf("spec.storageClasses[%d].storagePool %q does not match any storage pool", i, storageClass.StoragePool)
+		}
+		if storageClass.ReclaimPolicy != nil && *storageClass.ReclaimPolicy != corev1.PersistentVolumeReclaimDelete && *storageClass.ReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+			return fmt.Errorf("spec.storageClasses[%d].reclaimPolicy must be %s or %s", i, corev1.PersistentVolumeReclaimDelete, corev1.PersistentVolumeReclaimRetain)
+		}
+		if storageClass.VolumeBindingMode != nil && *storageClass.VolumeBindingMode != storagev1.VolumeBindingImmediate && *storageClass.VolumeBindingMode != storagev1.VolumeBindingWaitForFirstConsumer {
+			return fmt.Errorf("spec.storageClasses[%d].volumeBindingMode must be %s or %s", i, storagev1.VolumeBindingImmediate, storagev1.VolumeBindingWaitForFirstConsumer)
+		}
+		if storageClass.Default {
+			if defaultIndex >= 0 {
+				return fmt.Errorf("spec.storageClasses[%d] and spec.storageClasses[%d] are both default, only one storage class can be the default", i, defaultIndex)
+			}
+			defaultIndex = i
+		}
+	}
+	for i, storagePool := range hpp.Spec.StoragePools {
+		if !isSharedStoragePool(storagePool) {
+			continue
+		}
+		overlayClassName := storagePool.OverlayClassName
+		if overlayClassName == "" {
+			overlayClassName = DefaultOverlayClassName
+		}
+		if index, ok := usedNames[overlayClassName]; ok {
+			return fmt.Errorf("spec.storageClasses[%d].name is the same as the overlay storage class of spec.storagePools[%d]", index, i)
+		}
+	}
+	return nil
+}
+
+// validateComponentResources checks the quantities of each component are not negative, and the requests do not
+// exceed the limits. The pods of the component would be rejected otherwise.
+func validateComponentResources(resources *ComponentResources) error {
+	if resources == nil {
+		return nil
+	}
+	components := []struct {
+		name      string
+		resources *corev1.ResourceRequirements
+	}{
+		{"provisioner", resources.Provisioner},
+		{"nodeDriverRegistrar", resources.NodeDriverRegistrar},
+		{"livenessProbe", resources.LivenessProbe},
+		{"csiProvisioner", resources.CSIProvisioner},
+		{"csiSnapshotter", resources.CSISnapshotter},
+		{"mounter", resources.Mounter},
+		{"cleanup", resources.Cleanup},
+	}
+	for _, component := range components {
+		if component.resources == nil {
+			continue
+		}
+		for name, quantity := range component.resources.Limits {
+			if quantity.Sign() < 0 {
+				return fmt.Errorf("spec.resources.%s.limits.%s cannot be negative", component.name, name)
+			}
+		}
+		for name, quantity := range component.resources.Requests {
+			if quantity.Sign() < 0 {
+				return fmt.Errorf("spec.resources.%s.requests.%s cannot be negative", component.name, name)
+			}
+			if limit, ok := component.resources.Limits[name]; ok && quantity.Cmp(limit) > 0 {
+				return fmt.Errorf("spec.resources.%s.requests.%s %s cannot be greater than the limit %s", component.name, name, quantity.String(), limit.String())
+			}
+		}
+	}
+	return nil
+}
+
+// validatePodSettings checks the priority class, image pull secrets and images that are set on the pods of the
+// components.
+func validatePodSettings(hpp *HostPathProvisioner) error {
+	if hpp.Spec.PriorityClassName != "" {
+		if errs := validation.IsDNS1123Subdomain(hpp.Spec.PriorityClassName); len(errs) > 0 {
+			return fmt.Errorf("spec.priorityClassName %q is invalid: %s", hpp.Spec.PriorityClassName, strings.Join(errs, ", "))
+		}
+	}
+	for i, secret := range hpp.Spec.ImagePullSecrets {
+		if errs := validation.IsDNS1123Subdomain(secret.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.imagePullSecrets[%d].name %q is invalid: %s", i, secret.Name, strings.Join(errs, ", "))
+		}
+	}
+	if hpp.Spec.Images == nil {
+		return nil
+	}
+	images := []struct {
+		name  string
+		image string
+	}{
+		{"provisioner", hpp.Spec.Images.Provisioner},
+		{"legacyProvisioner", hpp.Spec.Images.LegacyProvisioner},
+		{"nodeDriverRegistrar", hpp.Spec.Images.NodeDriverRegistrar},
+		{"livenessProbe", hpp.Spec.Images.LivenessProbe},
+		{"csiProvisioner", hpp.Spec.Images.CSIProvisioner},
+		{"csiSnapshotter", hpp.Spec.Images.CSISnapshotter},
+		{"mounter", hpp.Spec.Images.Mounter},
+	}
+	for _, component := range images {
+		if strings.TrimSpace(component.image) != component.image {
+			return fmt.Errorf("spec.images.%s %q cannot have leading or trailing whitespace", component.name, component.image)
+		}
+	}
+	return nil
+}
+
+// validateLogVerbosity checks the verbosities are not negative, and each node has at most one override.
+func validateLogVerbosity(logVerbosity *LogVerbosity) error {
+	if logVerbosity == nil {
+		return nil
+	}
+	if err := validateVerbosity("spec.logVerbosity.default", logVerbosity.Default); err != nil {
+		return err
+	}
+	if err := validateVerbosity("spec.logVerbosity.operator", logVerbosity.Operator); err != nil {
+		return err
+	}
+	if err := validateComponentLogVerbosity("spec.logVerbosity.components", logVerbosity.Components); err != nil {
+		return err
+	}
+	usedNodes := make(map[string]int)
+	for i, node := range logVerbosity.Nodes {
+		if errs := validation.IsDNS1123Subdomain(node.NodeName); len(errs) > 0 {
+			return fmt.Errorf("spec.logVerbosity.nodes[%d].nodeName %q is invalid: %s", i, node.NodeName, strings.Join(errs, ", "))
+		}
+		if index, ok := usedNodes[node.NodeName]; ok {
+			return fmt.Errorf("spec.logVerbosity.nodes[%d].nodeName is the same as spec.logVerbosity.nodes[%d].nodeName, cannot have duplicate nodes", i, index)
+		}
+		usedNodes[node.NodeName] = i
+		if err := validateVerbosity(fmt.Sprintf("spec.logVerbosity.nodes[%d].default", i), node.Default); err != nil {
+			return err
+		}
+		if err := validateComponentLogVerbosity(fmt.Sprintf("spec.logVerbosity.nodes[%d].components", i), node.Components); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateComponentLogVerbosity(field string, components *ComponentLogVerbosity) error {
+	if components == nil {
+		return nil
+	}
+	verbosities := []struct {
+		name      string
+		verbosity *int32
+	}{
+		{"provisioner", components.Provisioner},
+		{"nodeDriverRegistrar", components.NodeDriverRegistrar},
+		{"csiProvisioner", components.CSIProvisioner},
+		{"csiSnapshotter", components.CSISnapshotter},
+		{"mounter", components.Mounter},
+	}
+	for _, component := range verbosities {
+		if err := validateVerbosity(fmt.Sprintf("%s.%s", field, component.name), component.verbosity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateVerbosity(field string, verbosity *int32) error {
+	if verbosity != nil && *verbosity < 0 {
+		return fmt.Errorf("%s cannot be negative", field)
+	}
+	return nil
 }
 
 func validateStoragePool(storagePool StoragePool) error {
@@ -98,5 +554,140 @@ func validateStoragePool(storagePool StoragePool) error {
 	if len(storagePool.Path) > maxPathLength {
 		return fmt.Errorf("storagePool.path cannot have a length greater than 255")
 	}
+	if err := validateStoragePoolPath(storagePool.Path); err != nil {
+		return err
+	}
+	if err := validateSnapshotProvider(storagePool.SnapshotProvider); err != nil {
+		return err
+	}
+	if errs := metav1validation.ValidateLabels(storagePool.NodeSelector, field.NewPath("storagePool", "nodeSelector")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if err := validateStoragePoolFilesystem(storagePool); err != nil {
+		return err
+	}
+	if err := validateStoragePoolUnmount(storagePool.Unmount); err != nil {
+		return err
+	}
+	return validateStoragePoolEncryption(storagePool)
+}
+
+func validateStoragePoolUnmount(unmount *StoragePoolUnmount) error {
+	if unmount != nil && unmount.Timeout != nil && unmount.Timeout.Duration <= 0 {
+		return fmt.Errorf("storagePool.unmount.timeout must be positive")
+	}
+	return nil
+}
+
+// validateStoragePoolFilesystem checks the filesystem settings are only used for storage pools with a block device on
+// each node, the mounter formats and mounts those devices.
+func validateStoragePoolFilesystem(storagePool StoragePool) error {
+	if storagePool.FSType == "" && len(storagePool.MkfsOptions) == 0 && len(storagePool.MountOptions) == 0 {
+		return nil
+	}
+	if storagePool.PVCTemplate == nil || getVolumeMode(storagePool.PVCTemplate) != corev1.PersistentVolumeBlock {
+		return fmt.Errorf("storagePool.fsType, mkfsOptions and mountOptions require a pvcTemplate with volumeMode %s", corev1.PersistentVolumeBlock)
+	}
+	if storagePool.FSType != "" && storagePool.FSType != FSTypeXFS && storagePool.FSType != FSTypeExt4 {
+		return fmt.Errorf("storagePool.fsType %q is not supported, only %s and %s are supported", storagePool.FSType, FSTypeXFS, FSTypeExt4)
+	}
+	for i, option := range storagePool.MkfsOptions {
+		if option == "" {
+			return fmt.Errorf("storagePool.mkfsOptions[%d] cannot be blank", i)
+		}
+	}
+	for i, option := range storagePool.MountOptions {
+		if option == "" || strings.ContainsAny(option, ", \t") {
+			return fmt.Errorf("storagePool.mountOptions[%d] %q must be a single mount option", i, option)
+		}
+	}
+	return nil
+}
+
+func getFSType(storagePool StoragePool) string {
+	if storagePool.FSType == "" {
+		return FSTypeXFS
+	}
+	return storagePool.FSType
+}
+
+// validateStoragePoolEncryption checks encryption is only used for storage pools with a block device on each node, and
+// the secret holding the encryption key is valid.
+func validateStoragePoolEncryption(storagePool StoragePool) error {
+	encryption := storagePool.Encryption
+	if encryption == nil {
+		return nil
+	}
+	if storagePool.PVCTemplate == nil || getVolumeMode(storagePool.PVCTemplate) != corev1.PersistentVolumeBlock {
+		return fmt.Errorf("storagePool.encryption requires a pvcTemplate with volumeMode %s", corev1.PersistentVolumeBlock)
+	}
+	for _, accessMode := range storagePool.PVCTemplate.AccessModes {
+		if accessMode == corev1.ReadWriteMany {
+			return fmt.Errorf("storagePool.encryption is not supported with a %s pvcTemplate", corev1.ReadWriteMany)
+		}
+	}
+	if errs := validation.IsDNS1123Subdomain(encryption.SecretName); len(errs) > 0 {
+		return fmt.Errorf("storagePool.encryption.secretName %q is invalid: %s", encryption.SecretName, strings.Join(errs, ", "))
+	}
+	if encryption.Key != "" {
+		if errs := validation.IsConfigMapKey(encryption.Key); len(errs) > 0 {
+			return fmt.Errorf("storagePool.encryption.key %q is invalid: %s", encryption.Key, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+func validateStoragePoolPath(poolPath string) error {
+	if !path.IsAbs(poolPath) {
+		return fmt.Errorf("storagePool.path %q must be an absolute path", poolPath)
+	}
+	cleanPath := path.Clean(poolPath)
+	for _, denylisted := range denylistedPaths {
+		if cleanPath == denylisted {
+			return fmt.Errorf("storagePool.path %q is a system path and cannot be used", poolPath)
+		}
+		// Every path is inside the root directory, it is only denied by itself.
+		if denylisted != "/" && isNestedPath(denylisted, cleanPath) {
+			return fmt.Errorf("storagePool.path %q is inside the system path %q and cannot be used", poolPath, denylisted)
+		}
+		if isNestedPath(cleanPath, denylisted) {
+			return fmt.Errorf("storagePool.path %q contains the system path %q and cannot be used", poolPath, denylisted)
+		}
+	}
 	return nil
 }
+
+// validateStoragePoolPathsDisjoint checks that the paths of two storage pools do not overlap, the CSI driver would
+// mix the volumes of both pools otherwise.
+func validateStoragePoolPathsDisjoint(first StoragePool, firstIndex int, second StoragePool, secondIndex int) error {
+	firstPath, secondPath := path.Clean(first.Path), path.Clean(second.Path)
+	switch {
+	case firstPath == secondPath:
+		return fmt.Errorf("spec.storagePools[%d].path is the same as spec.storagePools[%d].path, cannot have duplicate paths", secondIndex, firstIndex)
+	case isNestedPath(firstPath, secondPath):
+		return fmt.Errorf("spec.storagePools[%d].path %q is inside spec.storagePools[%d].path %q, cannot have nested paths", secondIndex, second.Path, firstIndex, first.Path)
+	case isNestedPath(secondPath, firstPath):
+		return fmt.Errorf("spec.storagePools[%d].path %q is inside spec.storagePools[%d].path %q, cannot have nested paths", firstIndex, first.Path, secondIndex, second.Path)
+	}
+	return nil
+}
+
+// isNestedPath returns true if child is a sub directory of parent, both paths must be clean and absolute.
+func isNestedPath(parent, child string) bool {
+	if parent == child {
+		return false
+	}
+	return parent == "/" || strings.HasPrefix(child, parent+"/")
+}
+
+func isSharedStoragePool(storagePool StoragePool) bool {
+	if storagePool.PVCTemplate == nil {
+		return false
+	}
+	for _, mode := range storagePool.PVCTemplate.AccessModes {
+		if mode == corev1.ReadWriteMany {
+			return true
+		}
+	}
+	return false
+}
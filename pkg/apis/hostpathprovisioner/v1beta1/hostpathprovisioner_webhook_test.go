@@ -18,10 +18,19 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	ginkgo "github.com/onsi/ginkgo/v2"
 	gomega "github.com/onsi/gomega"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
 )
 
 const (
@@ -33,15 +42,27 @@ const (
 )
 
 var (
+	// validCr is the current CR of the update tests, the updates replace its spec
+	validCr = HostPathProvisioner{
+		Spec: HostPathProvisionerSpec{
+			StoragePools: []StoragePool{
+				{
+					Name: "valid",
+					Path: "/var/valid",
+				},
+			},
+		},
+	}
+
 	bothLegacyAndVolumeCR = HostPathProvisioner{
 		Spec: HostPathProvisionerSpec{
 			PathConfig: &PathConfig{
-				Path: "test",
+				Path: "/var/test",
 			},
 			StoragePools: []StoragePool{
 				{
 					Name: "test",
-					Path: "test",
+					Path: "/var/test",
 				},
 			},
 		},
@@ -58,11 +79,11 @@ var (
 			StoragePools: []StoragePool{
 				{
 					Name: "test",
-					Path: "test",
+					Path: "/var/test",
 				},
 				{
 					Name: "test2",
-					Path: "test2",
+					Path: "/var/test2",
 				},
 			},
 		},
@@ -73,15 +94,15 @@ var (
 			StoragePools: []StoragePool{
 				{
 					Name: "test",
-					Path: "test",
+					Path: "/var/test",
 				},
 				{
 					Name: "test2",
-					Path: "test2",
+					Path: "/var/test2",
 				},
 				{
 					Name: "test3",
-					Path: "test",
+					Path: "/var/test",
 				},
 			},
 		},
@@ -92,15 +113,15 @@ var (
 			StoragePools: []StoragePool{
 				{
 					Name: "test",
-					Path: "test",
+					Path: "/var/test",
 				},
 				{
 					Name: "test2",
-					Path: "test2",
+					Path: "/var/test2",
 				},
 				{
 					Name: "test",
-					Path: "test3",
+					Path: "/var/test3",
 				},
 			},
 		},
@@ -110,7 +131,7 @@ var (
 		Spec: HostPathProvisionerSpec{
 			StoragePools: []StoragePool{
 				{
-					Path: "test",
+					Path: "/var/test",
 				},
 			},
 		},
@@ -120,7 +141,7 @@ var (
 			StoragePools: []StoragePool{
 				{
 					Name: "",
-					Path: "test",
+					Path: "/var/test",
 				},
 			},
 		},
@@ -149,7 +170,7 @@ var (
 			StoragePools: []StoragePool{
 				{
 					Name:        "test",
-					Path:        "test",
+					Path:        "/var/test",
 					PVCTemplate: &corev1.PersistentVolumeClaimSpec{},
 				},
 			},
@@ -160,11 +181,11 @@ var (
 			StoragePools: []StoragePool{
 				{
 					Name: "1234567890123456789012345678901234567890123456789",
-					Path: "test",
+					Path: "/var/test",
 				},
 				{
 					Name: "l12345678901234567890123456789012345678901234567890",
-					Path: "test2",
+					Path: "/var/test2",
 				},
 			},
 		},
@@ -183,6 +204,19 @@ var (
 			},
 		},
 	}
+	invalidNodeSelectorCr = HostPathProvisioner{
+		Spec: HostPathProvisionerSpec{
+			StoragePools: []StoragePool{
+				{
+					Name: "test",
+					Path: "/var/test",
+					NodeSelector: map[string]string{
+						"disk type": "nvme",
+					},
+				},
+			},
+		},
+	}
 )
 
 var _ = ginkgo.Describe("validating webhook", func() {
@@ -237,52 +271,890 @@ var _ = ginkgo.Describe("validating webhook", func() {
 			_, err := hppCrValidator.ValidateCreate(context.Background(), &longPathCr)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.path cannot have a length greater than 255")))
 		})
+		ginkgo.It("Should not allow invalid storagepool.nodeSelector labels", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), &invalidNodeSelectorCr)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("storagePool.nodeSelector"))
+		})
+	})
+
+	ginkgo.Context("storage pool paths", func() {
+		createPathCr := func(paths ...string) *HostPathProvisioner {
+			cr := &HostPathProvisioner{}
+			for i, path := range paths {
+				cr.Spec.StoragePools = append(cr.Spec.StoragePools, StoragePool{
+					Name: fmt.Sprintf("pool%d", i),
+					Path: path,
+				})
+			}
+			return cr
+		}
+
+		ginkgo.It("Should allow pools with similar but separate paths", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createPathCr("/var/hpp", "/var/hpp-fast", "/mnt/hpp/fast"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject unsafe paths", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("%s", expected)))
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), &validCr, cr)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("%s", expected)))
+		},
+			ginkgo.Entry("relative path", createPathCr("var/hpp"),
+				`storagePool.path "var/hpp" must be an absolute path`),
+			ginkgo.Entry("root", createPathCr("/"),
+				`storagePool.path "/" is a system path and cannot be used`),
+			ginkgo.Entry("etc", createPathCr("/etc"),
+				`storagePool.path "/etc" is a system path and cannot be used`),
+			ginkgo.Entry("kubelet directory", createPathCr("/var/lib/kubelet/"),
+				`storagePool.path "/var/lib/kubelet/" is a system path and cannot be used`),
+			ginkgo.Entry("inside etc", createPathCr("/etc/hpp"),
+				`storagePool.path "/etc/hpp" is inside the system path "/etc" and cannot be used`),
+			ginkgo.Entry("inside the kubelet directory", createPathCr("/var/lib/kubelet/hpp"),
+				`storagePool.path "/var/lib/kubelet/hpp" is inside the system path "/var/lib/kubelet" and cannot be used`),
+			ginkgo.Entry("escaping with ..", createPathCr("/var/hpp/../../etc/hpp"),
+				`storagePool.path "/var/hpp/../../etc/hpp" is inside the system path "/etc" and cannot be used`),
+			ginkgo.Entry("containing the kubelet directory", createPathCr("/var/lib"),
+				`storagePool.path "/var/lib" contains the system path "/var/lib/kubelet" and cannot be used`),
+			ginkgo.Entry("same path after cleaning", createPathCr("/var/hpp", "/var/hpp/"),
+				"spec.storagePools[1].path is the same as spec.storagePools[0].path, cannot have duplicate paths"),
+			ginkgo.Entry("nested in an earlier pool", createPathCr("/var/hpp", "/var/hpp/fast"),
+				`spec.storagePools[1].path "/var/hpp/fast" is inside spec.storagePools[0].path "/var/hpp", cannot have nested paths`),
+			ginkgo.Entry("containing an earlier pool", createPathCr("/mnt/hpp/fast", "/mnt/data", "/mnt/hpp"),
+				`spec.storagePools[0].path "/mnt/hpp/fast" is inside spec.storagePools[2].path "/mnt/hpp", cannot have nested paths`),
+		)
+	})
+
+	ginkgo.Context("storage classes", func() {
+		createStorageClassCr := func(storageClasses ...StorageClass) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "local",
+							Path: "/var/hpvolumes",
+						},
+						{
+							Name: "shared",
+							Path: "/var/hpvolumes-shared",
+							PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+							},
+						},
+					},
+					StorageClasses: storageClasses,
+				},
+			}
+		}
+
+		ginkgo.It("Should allow valid storage classes", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createStorageClassCr(
+				StorageClass{Name: "hostpath-csi", StoragePool: "local", Default: true},
+				StorageClass{Name: "hostpath-csi-shared", StoragePool: "shared"},
+			))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should allow the legacy storage pool with a legacy CR", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			cr := &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					PathConfig: &PathConfig{
+						Path: "/var/hpvolumes",
+					},
+					StorageClasses: []StorageClass{{Name: "hostpath-csi", StoragePool: "legacy"}},
+				},
+			}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject invalid storage classes", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring(expected))
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), &validCr, cr)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring(expected))
+		},
+			ginkgo.Entry("invalid name", createStorageClassCr(
+				StorageClass{Name: "Hostpath_CSI", StoragePool: "local"},
+			), `spec.storageClasses[0].name "Hostpath_CSI" is invalid`),
+			ginkgo.Entry("duplicate name", createStorageClassCr(
+				StorageClass{Name: "hostpath-csi", StoragePool: "local"},
+				StorageClass{Name: "hostpath-csi", StoragePool: "shared"},
+			), "spec.storageClasses[1].name is the same as spec.storageClasses[0].name, cannot have duplicate names"),
+			ginkgo.Entry("unknown storage pool", createStorageClassCr(
+				StorageClass{Name: "hostpath-csi", StoragePool: "unknown"},
+			), `spec.storageClasses[0].storagePool "unknown" does not match any storage pool`),
+			ginkgo.Entry("invalid reclaim policy", createStorageClassCr(
+				StorageClass{Name: "hostpath-csi", StoragePool: "local", ReclaimPolicy: ptr.To(corev1.PersistentVolumeReclaimRecycle)},
+			), "spec.storageClasses[0].reclaimPolicy must be Delete or Retain"),
+			ginkgo.Entry("invalid binding mode", createStorageClassCr(
+				StorageClass{Name: "hostpath-csi", StoragePool: "local", VolumeBindingMode: ptr.To(storagev1.VolumeBindingMode("Later"))},
+			), "spec.storageClasses[0].volumeBindingMode must be Immediate or WaitForFirstConsumer"),
+			ginkgo.Entry("multiple defaults", createStorageClassCr(
+				StorageClass{Name: "hostpath-csi", StoragePool: "local", Default: true},
+				StorageClass{Name: "hostpath-csi-shared", StoragePool: "shared", Default: true},
+			), "spec.storageClasses[1] and spec.storageClasses[0] are both default"),
+			ginkgo.Entry("overlay storage class name", createStorageClassCr(
+				StorageClass{Name: "hpp-overlay", StoragePool: "local"},
+			), "spec.storageClasses[0].name is the same as the overlay storage class of spec.storagePools[1]"),
+		)
+	})
+
+	ginkgo.Context("feature gates and snapshot providers", func() {
+		createFeatureGateCr := func(snapshotProvider string, featureGates ...string) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					FeatureGates: featureGates,
+					StoragePools: []StoragePool{
+						{
+							Name:             "local",
+							Path:             "/var/hpvolumes",
+							SnapshotProvider: ptr.To(snapshotProvider),
+						},
+					},
+				},
+			}
+		}
+
+		ginkgo.It("Should allow known feature gates and snapshot providers", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			warnings, err := hppCrValidator.ValidateCreate(context.Background(), createFeatureGateCr("reflink", "Snapshotting"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(warnings).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should warn about deprecated feature gates", func() {
+			knownFeatureGates["OldFeature"] = "it is enabled by default"
+			ginkgo.DeferCleanup(func() {
+				delete(knownFeatureGates, "OldFeature")
+			})
+			hppCrValidator := HostPathProvisionerValidator{}
+			cr := createFeatureGateCr("reflink", "Snapshotting", "OldFeature")
+			warnings, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(warnings).To(gomega.ConsistOf(`spec.featureGates[1] "OldFeature" is deprecated: it is enabled by default`))
+			warnings, err = hppCrValidator.ValidateUpdate(context.Background(), &validCr, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(warnings).To(gomega.HaveLen(1))
+		})
+
+		ginkgo.DescribeTable("Should reject unknown values", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("%s", expected)))
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), &validCr, cr)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("%s", expected)))
+		},
+			ginkgo.Entry("misspelled feature gate", createFeatureGateCr("reflink", "Snapshoting"),
+				`spec.featureGates[0] "Snapshoting" is not a known feature gate, did you mean "Snapshotting"?`),
+			ginkgo.Entry("feature gate with the wrong case", createFeatureGateCr("reflink", "snapshotting"),
+				`spec.featureGates[0] "snapshotting" is not a known feature gate, did you mean "Snapshotting"?`),
+			ginkgo.Entry("unknown feature gate", createFeatureGateCr("reflink", "Snapshotting", "Encryption"),
+				`spec.featureGates[1] "Encryption" is not a known feature gate, must be one of: Snapshotting`),
+			ginkgo.Entry("misspelled snapshot provider", createFeatureGateCr("relink", "Snapshotting"),
+				`storagePool.snapshotProvider "relink" is not a known snapshot provider, did you mean "reflink"?`),
+			ginkgo.Entry("unknown snapshot provider", createFeatureGateCr("btrfs", "Snapshotting"),
+				`storagePool.snapshotProvider "btrfs" is not a known snapshot provider, must be one of: reflink`),
+		)
+	})
+
+	ginkgo.Context("component resources", func() {
+		createResourcesCr := func(resources *ComponentResources) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "local",
+							Path: "/var/hpvolumes",
+						},
+					},
+					Resources: resources,
+				},
+			}
+		}
+
+		ginkgo.It("Should allow requests within the limits", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createResourcesCr(&ComponentResources{
+				Provisioner: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("100Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("100Mi"),
+					},
+				},
+				Mounter: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+			}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject invalid resources", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("%s", expected)))
+		},
+			ginkgo.Entry("request above the limit", createResourcesCr(&ComponentResources{
+				CSIProvisioner: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("100Mi"),
+					},
+				},
+			}), "spec.resources.csiProvisioner.requests.memory 200Mi cannot be greater than the limit 100Mi"),
+			ginkgo.Entry("negative limit", createResourcesCr(&ComponentResources{
+				Cleanup: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("-1"),
+					},
+				},
+			}), "spec.resources.cleanup.limits.cpu cannot be negative"),
+		)
+	})
+
+	ginkgo.Context("pod settings", func() {
+		createPodSettingsCr := func(priorityClassName, pullSecret, image string) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "local",
+							Path: "/var/hpvolumes",
+						},
+					},
+					PriorityClassName: priorityClassName,
+					ImagePullSecrets: []corev1.LocalObjectReference{
+						{Name: pullSecret},
+					},
+					Images: &ComponentImages{
+						Provisioner: image,
+					},
+				},
+			}
+		}
+
+		ginkgo.It("Should allow a valid priority class, pull secrets and images", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createPodSettingsCr("system-node-critical", "mirror-pull-secret", "mirror.example.com/hostpath-provisioner-csi:hotfix"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject invalid pod settings", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.HavePrefix(expected))
+		},
+			ginkgo.Entry("invalid priority class", createPodSettingsCr("Critical", "pull-secret", ""),
+				`spec.priorityClassName "Critical" is invalid`),
+			ginkgo.Entry("blank pull secret", createPodSettingsCr("", "", ""),
+				`spec.imagePullSecrets[0].name "" is invalid`),
+			ginkgo.Entry("image with whitespace", createPodSettingsCr("", "pull-secret", "hostpath-provisioner-csi "),
+				`spec.images.provisioner "hostpath-provisioner-csi " cannot have leading or trailing whitespace`),
+		)
+	})
+
+	ginkgo.Context("encryption", func() {
+		block := corev1.PersistentVolumeBlock
+		filesystem := corev1.PersistentVolumeFilesystem
+
+		createEncryptedCr := func(volumeMode *corev1.PersistentVolumeMode, accessMode corev1.PersistentVolumeAccessMode, encryption *StoragePoolEncryption) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "encrypted",
+							Path: "/var/encrypted",
+							PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+								VolumeMode:  volumeMode,
+								AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+							},
+							Encryption: encryption,
+						},
+					},
+				},
+			}
+		}
+
+		ginkgo.It("Should allow encryption of a block storage pool", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createEncryptedCr(&block, corev1.ReadWriteOnce, &StoragePoolEncryption{SecretName: "pool-key", Key: "passphrase"}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject invalid encryption", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.HavePrefix(expected))
+		},
+			ginkgo.Entry("filesystem pool", createEncryptedCr(&filesystem, corev1.ReadWriteOnce, &StoragePoolEncryption{SecretName: "pool-key"}),
+				"storagePool.encryption requires a pvcTemplate with volumeMode Block"),
+			ginkgo.Entry("default volume mode", createEncryptedCr(nil, corev1.ReadWriteOnce, &StoragePoolEncryption{SecretName: "pool-key"}),
+				"storagePool.encryption requires a pvcTemplate with volumeMode Block"),
+			ginkgo.Entry("shared pool", createEncryptedCr(&block, corev1.ReadWriteMany, &StoragePoolEncryption{SecretName: "pool-key"}),
+				"storagePool.encryption is not supported with a ReadWriteMany pvcTemplate"),
+			ginkgo.Entry("blank secret name", createEncryptedCr(&block, corev1.ReadWriteOnce, &StoragePoolEncryption{}),
+				`storagePool.encryption.secretName "" is invalid`),
+			ginkgo.Entry("invalid key", createEncryptedCr(&block, corev1.ReadWriteOnce, &StoragePoolEncryption{SecretName: "pool-key", Key: "a/b"}),
+				`storagePool.encryption.key "a/b" is invalid`),
+		)
+	})
+
+	ginkgo.Context("filesystem", func() {
+		block := corev1.PersistentVolumeBlock
+
+		createFilesystemCr := func(volumeMode *corev1.PersistentVolumeMode, fsType string, mkfsOptions, mountOptions []string) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "local",
+							Path: "/var/hpvolumes",
+							PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+								VolumeMode: volumeMode,
+							},
+							FSType:       fsType,
+							MkfsOptions:  mkfsOptions,
+							MountOptions: mountOptions,
+						},
+					},
+				},
+			}
+		}
+
+		ginkgo.It("Should allow the filesystem settings of a block storage pool", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createFilesystemCr(&block, FSTypeExt4, []string{"-m", "1"}, []string{"noatime", "discard"}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject invalid filesystem settings", func(cr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.HavePrefix(expected))
+		},
+			ginkgo.Entry("filesystem pool", createFilesystemCr(nil, FSTypeXFS, nil, nil),
+				"storagePool.fsType, mkfsOptions and mountOptions require a pvcTemplate with volumeMode Block"),
+			ginkgo.Entry("unsupported filesystem", createFilesystemCr(&block, "btrfs", nil, nil),
+				`storagePool.fsType "btrfs" is not supported`),
+			ginkgo.Entry("blank mkfs option", createFilesystemCr(&block, "", []string{""}, nil),
+				"storagePool.mkfsOptions[0] cannot be blank"),
+			ginkgo.Entry("multiple mount options in one", createFilesystemCr(&block, "", nil, []string{"noatime,discard"}),
+				`storagePool.mountOptions[0] "noatime,discard" must be a single mount option`),
+		)
+	})
+
+	ginkgo.Context("unmount", func() {
+		createUnmountCr := func(unmount *StoragePoolUnmount) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name:    "local",
+							Path:    "/var/hpvolumes",
+							Unmount: unmount,
+						},
+					},
+				},
+			}
+		}
+
+		ginkgo.DescribeTable("Should validate the unmount timeout", func(unmount *StoragePoolUnmount, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createUnmountCr(unmount))
+			if expected == "" {
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).To(gomega.MatchError(expected))
+			}
+		},
+			ginkgo.Entry("default timeout", &StoragePoolUnmount{OnTermination: true, Lazy: true}, ""),
+			ginkgo.Entry("timeout", &StoragePoolUnmount{Timeout: &metav1.Duration{Duration: time.Minute}}, ""),
+			ginkgo.Entry("zero timeout", &StoragePoolUnmount{Timeout: &metav1.Duration{}}, "storagePool.unmount.timeout must be positive"),
+			ginkgo.Entry("negative timeout", &StoragePoolUnmount{Timeout: &metav1.Duration{Duration: -time.Second}}, "storagePool.unmount.timeout must be positive"),
+		)
+	})
+
+	ginkgo.Context("stranded volumes", func() {
+		ginkgo.DescribeTable("Should validate the stranded volume grace period", func(gracePeriod *metav1.Duration, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			cr := &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "local",
+							Path: "/var/hpvolumes",
+						},
+					},
+					StrandedVolumeGracePeriod: gracePeriod,
+				},
+			}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), cr)
+			if expected == "" {
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).To(gomega.MatchError(expected))
+			}
+		},
+			ginkgo.Entry("default grace period", nil, ""),
+			ginkgo.Entry("grace period", &metav1.Duration{Duration: time.Hour}, ""),
+			ginkgo.Entry("zero grace period", &metav1.Duration{}, "spec.strandedVolumeGracePeriod must be positive"),
+			ginkgo.Entry("negative grace period", &metav1.Duration{Duration: -time.Minute}, "spec.strandedVolumeGracePeriod must be positive"),
+		)
+	})
+
+	ginkgo.Context("log verbosity", func() {
+		createLogVerbosityCr := func(logVerbosity *LogVerbosity) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "local",
+							Path: "/var/hpvolumes",
+						},
+					},
+					LogVerbosity: logVerbosity,
+				},
+			}
+		}
+
+		ginkgo.It("Should allow component and node verbosities", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createLogVerbosityCr(&LogVerbosity{
+				Default:  ptr.To[int32](2),
+				Operator: ptr.To[int32](1),
+				Components: &ComponentLogVerbosity{
+					CSIProvisioner: ptr.To[int32](5),
+				},
+				Nodes: []NodeLogVerbosity{
+					{
+						NodeName: "node1",
+						Default:  ptr.To[int32](6),
+					},
+				},
+			}))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("Should reject invalid verbosities", func(logVerbosity *LogVerbosity, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateCreate(context.Background(), createLogVerbosityCr(logVerbosity))
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.HavePrefix(expected))
+		},
+			ginkgo.Entry("negative default", &LogVerbosity{
+				Default: ptr.To[int32](-1),
+			}, "spec.logVerbosity.default cannot be negative"),
+			ginkgo.Entry("negative component", &LogVerbosity{
+				Components: &ComponentLogVerbosity{
+					Mounter: ptr.To[int32](-1),
+				},
+			}, "spec.logVerbosity.components.mounter cannot be negative"),
+			ginkgo.Entry("blank node name", &LogVerbosity{
+				Nodes: []NodeLogVerbosity{{}},
+			}, `spec.logVerbosity.nodes[0].nodeName "" is invalid`),
+			ginkgo.Entry("duplicate node", &LogVerbosity{
+				Nodes: []NodeLogVerbosity{
+					{NodeName: "node1"},
+					{NodeName: "node1"},
+				},
+			}, "spec.logVerbosity.nodes[1].nodeName is the same as spec.logVerbosity.nodes[0].nodeName"),
+			ginkgo.Entry("negative node component", &LogVerbosity{
+				Nodes: []NodeLogVerbosity{
+					{
+						NodeName: "node1",
+						Components: &ComponentLogVerbosity{
+							NodeDriverRegistrar: ptr.To[int32](-2),
+						},
+					},
+				},
+			}, "spec.logVerbosity.nodes[0].components.nodeDriverRegistrar cannot be negative"),
+		)
+	})
+
+	ginkgo.Context("update transitions", func() {
+		filesystem := corev1.PersistentVolumeFilesystem
+		block := corev1.PersistentVolumeBlock
+
+		createTransitionCr := func(storagePools ...StoragePool) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "hostpath-provisioner",
+				},
+				Spec: HostPathProvisionerSpec{
+					StoragePools: storagePools,
+				},
+			}
+		}
+
+		createPV := func(name, storagePool string, phase corev1.PersistentVolumePhase) *corev1.PersistentVolume {
+			return &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{
+							Driver:           util.CSIDriverName,
+							VolumeHandle:     name,
+							VolumeAttributes: map[string]string{storagePoolVolumeAttribute: storagePool},
+						},
+					},
+				},
+				Status: corev1.PersistentVolumeStatus{
+					Phase: phase,
+				},
+			}
+		}
+
+		local := StoragePool{Name: "local", Path: "/var/hpvolumes"}
+		other := StoragePool{Name: "other", Path: "/var/other"}
+		blockPool := StoragePool{Name: "block", Path: "/var/block", PVCTemplate: &corev1.PersistentVolumeClaimSpec{VolumeMode: &block}}
+
+		ginkgo.It("Should allow adding a storage pool and unrelated changes", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			updated := local.DeepCopy()
+			updated.NodeSelector = map[string]string{"disk": "ssd"}
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), createTransitionCr(local), createTransitionCr(*updated, other))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should allow removing a storage pool without bound volumes", func() {
+			hppCrValidator := HostPathProvisionerValidator{
+				Client: fake.NewClientBuilder().WithObjects(
+					createPV("pv1", "other", corev1.VolumeReleased),
+					createPV("pv2", "local", corev1.VolumeBound),
+				).Build(),
+			}
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), createTransitionCr(local, other), createTransitionCr(local))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should not allow removing a storage pool with bound volumes", func() {
+			hppCrValidator := HostPathProvisionerValidator{
+				Client: fake.NewClientBuilder().WithObjects(
+					createPV("pv1", "other", corev1.VolumeBound),
+					createPV("pv2", "other", corev1.VolumeBound),
+					createPV("pv3", "local", corev1.VolumeBound),
+				).Build(),
+			}
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), createTransitionCr(local, other), createTransitionCr(local))
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf(`storage pool "other" cannot be removed, it is used by 2 bound persistent volumes`)))
+		})
+
+		ginkgo.It("Should allow destructive changes with the force annotation", func() {
+			hppCrValidator := HostPathProvisionerValidator{
+				Client: fake.NewClientBuilder().WithObjects(createPV("pv1", "other", corev1.VolumeBound)).Build(),
+			}
+			moved := local.DeepCopy()
+			moved.Path = "/var/moved"
+			newCr := createTransitionCr(*moved)
+			newCr.Annotations = map[string]string{ForceUpdateAnnotation: "true"}
+			warnings, err := hppCrValidator.ValidateUpdate(context.Background(), createTransitionCr(local, other), newCr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(warnings).To(gomega.HaveLen(1))
+			gomega.Expect(warnings[0]).To(gomega.ContainSubstring(ForceUpdateAnnotation))
+		})
+
+		ginkgo.DescribeTable("Should reject changes that strand volumes", func(oldCr, newCr *HostPathProvisioner, expected string) {
+			hppCrValidator := HostPathProvisionerValidator{}
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), oldCr, newCr)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("%s", expected)))
+		},
+			ginkgo.Entry("path change",
+				createTransitionCr(local),
+				createTransitionCr(StoragePool{Name: "local", Path: "/var/moved"}),
+				`spec.storagePools[0].path cannot be changed from "/var/hpvolumes" to "/var/moved", the volumes of storage pool "local" would be stranded`),
+			ginkgo.Entry("rename",
+				createTransitionCr(local, other),
+				createTransitionCr(StoragePool{Name: "renamed", Path: "/var/hpvolumes"}, other),
+				`storage pool "local" cannot be renamed to "renamed", the volumes of the storage pool would be stranded`),
+			ginkgo.Entry("pvc template added",
+				createTransitionCr(local),
+				createTransitionCr(StoragePool{Name: "local", Path: "/var/hpvolumes", PVCTemplate: &corev1.PersistentVolumeClaimSpec{VolumeMode: &filesystem}}),
+				`spec.storagePools[0].pvcTemplate cannot be added or removed, the volumes of storage pool "local" would be stranded`),
+			ginkgo.Entry("pvc template removed",
+				createTransitionCr(blockPool),
+				createTransitionCr(StoragePool{Name: "block", Path: "/var/block"}),
+				`spec.storagePools[0].pvcTemplate cannot be added or removed, the volumes of storage pool "block" would be stranded`),
+			ginkgo.Entry("volume mode change",
+				createTransitionCr(blockPool),
+				createTransitionCr(StoragePool{Name: "block", Path: "/var/block", PVCTemplate: &corev1.PersistentVolumeClaimSpec{VolumeMode: &filesystem}}),
+				`spec.storagePools[0].pvcTemplate.volumeMode cannot be changed from Block to Filesystem, the volumes of storage pool "block" would be stranded`),
+			ginkgo.Entry("volume mode change to the default",
+				createTransitionCr(blockPool),
+				createTransitionCr(StoragePool{Name: "block", Path: "/var/block", PVCTemplate: &corev1.PersistentVolumeClaimSpec{}}),
+				`spec.storagePools[0].pvcTemplate.volumeMode cannot be changed from Block to Filesystem, the volumes of storage pool "block" would be stranded`),
+			ginkgo.Entry("filesystem type change",
+				createTransitionCr(blockPool),
+				createTransitionCr(StoragePool{Name: "block", Path: "/var/block", PVCTemplate: &corev1.PersistentVolumeClaimSpec{VolumeMode: &block}, FSType: FSTypeExt4}),
+				`spec.storagePools[0].fsType cannot be changed from xfs to ext4, the devices of storage pool "block" are already formatted`),
+			ginkgo.Entry("encryption added",
+				createTransitionCr(blockPool),
+				createTransitionCr(StoragePool{Name: "block", Path: "/var/block", PVCTemplate: &corev1.PersistentVolumeClaimSpec{VolumeMode: &block}, Encryption: &StoragePoolEncryption{SecretName: "pool-key"}}),
+				`spec.storagePools[0].encryption cannot be added or removed, the devices of storage pool "block" are already formatted`),
+		)
+	})
+
+	ginkgo.Context("update ratcheting", func() {
+		// createInvalidCr returns a CR that is no longer valid, like a CR accepted by an older version of the operator
+		createInvalidCr := func() *HostPathProvisioner {
+			return &HostPathProvisioner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "hostpath-provisioner",
+					Finalizers: []string{"finalizer.delete.hostpath-provisioner"},
+				},
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{Name: "local", Path: "/var/hpvolumes"},
+						{Name: "system", Path: "/etc/hpvolumes"},
+					},
+					LogVerbosity: &LogVerbosity{Default: ptr.To[int32](-1)},
+				},
+			}
+		}
+
+		ginkgo.It("Should allow status and metadata updates if the spec is unchanged", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			oldCr := createInvalidCr()
+			newCr := oldCr.DeepCopy()
+			newCr.Status.Conditions = []conditions.Condition{{Type: conditions.ConditionAvailable, Status: corev1.ConditionTrue}}
+			newCr.Labels = map[string]string{"updated": "true"}
+			warnings, err := hppCrValidator.ValidateUpdate(context.Background(), oldCr, newCr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(warnings).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should allow removing the finalizer of a deleted CR", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			oldCr := createInvalidCr()
+			oldCr.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			newCr := oldCr.DeepCopy()
+			newCr.Finalizers = nil
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), oldCr, newCr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			ginkgo.By("Changing the spec of the deleted CR")
+			newCr.Spec.StoragePools[0].Path = "/etc/moved"
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), oldCr, newCr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should only validate the fields that changed", func() {
+			hppCrValidator := HostPathProvisionerValidator{}
+			oldCr := createInvalidCr()
+			newCr := oldCr.DeepCopy()
+			newCr.Spec.StoragePools[0].NodeSelector = map[string]string{"disk": "ssd"}
+			newCr.Spec.StoragePools = append(newCr.Spec.StoragePools, StoragePool{Name: "other", Path: "/var/other"})
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), oldCr, newCr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			ginkgo.By("Changing the invalid storage pool")
+			changedPool := oldCr.DeepCopy()
+			changedPool.Spec.StoragePools[1].NodeSelector = map[string]string{"disk": "ssd"}
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), oldCr, changedPool)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf(`storagePool.path "/etc/hpvolumes" is inside the system path "/etc" and cannot be used`)))
+
+			ginkgo.By("Adding a storage pool that overlaps an existing one")
+			overlapping := oldCr.DeepCopy()
+			overlapping.Spec.StoragePools = append(overlapping.Spec.StoragePools, StoragePool{Name: "nested", Path: "/var/hpvolumes/nested"})
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), oldCr, overlapping)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf(`spec.storagePools[2].path "/var/hpvolumes/nested" is inside spec.storagePools[0].path "/var/hpvolumes", cannot have nested paths`)))
+
+			ginkgo.By("Changing the invalid log verbosity")
+			changedVerbosity := oldCr.DeepCopy()
+			changedVerbosity.Spec.LogVerbosity.Operator = ptr.To[int32](2)
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), oldCr, changedVerbosity)
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("spec.logVerbosity.default cannot be negative")))
+		})
+	})
+
+	ginkgo.Context("delete", func() {
+		createDeleteCr := func(strategy *UninstallStrategy) *HostPathProvisioner {
+			return &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools:      []StoragePool{{Name: "local", Path: "/var/hpvolumes"}},
+					UninstallStrategy: strategy,
+				},
+			}
+		}
+
+		createValidator := func() *HostPathProvisionerValidator {
+			return &HostPathProvisionerValidator{
+				Client: fake.NewClientBuilder().WithObjects(
+					&corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{Name: "csi"},
+						Spec: corev1.PersistentVolumeSpec{
+							PersistentVolumeSource: corev1.PersistentVolumeSource{
+								CSI: &corev1.CSIPersistentVolumeSource{Driver: util.CSIDriverName, VolumeHandle: "csi"},
+							},
+						},
+					},
+					&corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "legacy",
+							Annotations: map[string]string{util.ProvisionedByAnnotation: util.LegacyProvisionerName},
+						},
+					},
+					&corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{Name: "other"},
+						Spec: corev1.PersistentVolumeSpec{
+							PersistentVolumeSource: corev1.PersistentVolumeSource{
+								CSI: &corev1.CSIPersistentVolumeSource{Driver: "other.csi.driver", VolumeHandle: "other"},
+							},
+						},
+					},
+				).Build(),
+			}
+		}
+
+		ginkgo.It("Should allow deleting with volumes by default", func() {
+			_, err := createValidator().ValidateDelete(context.Background(), createDeleteCr(nil))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = createValidator().ValidateDelete(context.Background(), createDeleteCr(ptr.To(UninstallStrategyRemoveWorkloads)))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should not allow deleting with volumes when the uninstall strategy blocks it", func() {
+			_, err := createValidator().ValidateDelete(context.Background(), createDeleteCr(ptr.To(UninstallStrategyBlockUninstallIfPVsExist)))
+			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("cannot delete the hostpath provisioner, the uninstall strategy is BlockUninstallIfPVsExist and 2 persistent volumes exist")))
+		})
+
+		ginkgo.It("Should allow deleting without volumes when the uninstall strategy blocks it", func() {
+			hppCrValidator := HostPathProvisionerValidator{Client: fake.NewClientBuilder().Build()}
+			_, err := hppCrValidator.ValidateDelete(context.Background(), createDeleteCr(ptr.To(UninstallStrategyBlockUninstallIfPVsExist)))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("defaulting", func() {
+		ginkgo.It("Should set the defaults", func() {
+			cr := &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					StoragePools: []StoragePool{
+						{
+							Name: "basic",
+							Path: "/var/basic",
+						},
+						{
+							Name:        "template",
+							Path:        "/var/template",
+							PVCTemplate: &corev1.PersistentVolumeClaimSpec{},
+						},
+						{
+							Name: "shared",
+							Path: "/var/shared",
+							PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+							},
+						},
+					},
+				},
+			}
+			defaulter := HostPathProvisionerDefaulter{}
+			err := defaulter.Default(context.Background(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cr.Spec.ImagePullPolicy).To(gomega.Equal(corev1.PullIfNotPresent))
+			gomega.Expect(cr.Spec.UninstallStrategy).To(gomega.Equal(ptr.To(UninstallStrategyRemoveWorkloads)))
+			gomega.Expect(cr.Spec.StrandedVolumePolicy).To(gomega.Equal(ptr.To(StrandedVolumePolicyReport)))
+			gomega.Expect(cr.Spec.StoragePools[0]).To(gomega.Equal(StoragePool{Name: "basic", Path: "/var/basic", NearlyFullThreshold: ptr.To(DefaultNearlyFullThreshold)}))
+			gomega.Expect(cr.Spec.StoragePools[1].PVCTemplate.AccessModes).To(gomega.ConsistOf(corev1.ReadWriteOnce))
+			gomega.Expect(cr.Spec.StoragePools[1].PVCTemplate.VolumeMode).To(gomega.Equal(ptr.To(corev1.PersistentVolumeFilesystem)))
+			gomega.Expect(cr.Spec.StoragePools[1].OverlayClassName).To(gomega.BeEmpty())
+			gomega.Expect(cr.Spec.StoragePools[2].PVCTemplate.AccessModes).To(gomega.ConsistOf(corev1.ReadWriteMany))
+			gomega.Expect(cr.Spec.StoragePools[2].OverlayClassName).To(gomega.Equal(DefaultOverlayClassName))
+		})
+
+		ginkgo.It("Should not override values that are set", func() {
+			cr := &HostPathProvisioner{
+				Spec: HostPathProvisionerSpec{
+					ImagePullPolicy:      corev1.PullAlways,
+					UninstallStrategy:    ptr.To(UninstallStrategyBlockUninstallIfPVsExist),
+					StrandedVolumePolicy: ptr.To(StrandedVolumePolicyDelete),
+					StoragePools: []StoragePool{
+						{
+							Name:                "shared",
+							Path:                "/var/shared",
+							OverlayClassName:    "shared-overlay",
+							NearlyFullThreshold: ptr.To[int32](75),
+							PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+								VolumeMode:  ptr.To(corev1.PersistentVolumeBlock),
+							},
+						},
+					},
+				},
+			}
+			expected := cr.DeepCopy()
+			defaulter := HostPathProvisionerDefaulter{}
+			err := defaulter.Default(context.Background(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cr).To(gomega.Equal(expected))
+		})
 	})
 
 	ginkgo.Context("update", func() {
 		ginkgo.It("Either legacy or volume sources have to be set.", func() {
 			hppCr := HostPathProvisioner{}
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &hppCr, &hppCr)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &hppCr)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("either pathConfig or storage pools must be set")))
 		})
 		ginkgo.It("Both legacy or volume sources cannot to be set.", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &bothLegacyAndVolumeCR, &bothLegacyAndVolumeCR)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &bothLegacyAndVolumeCR)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("pathConfig and storage pools cannot be both set")))
 		})
 		ginkgo.It("Cannot have blank kind in volume source", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &blankNameCr1, &blankNameCr1)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &blankNameCr1)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.name cannot be blank")))
-			_, err = hppCrValidator.ValidateUpdate(context.Background(), &blankNameCr2, &blankNameCr2)
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), &validCr, &blankNameCr2)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.name cannot be blank")))
 		})
 		ginkgo.It("Cannot have blank path in volume source", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &blankPathCr1, &blankPathCr1)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &blankPathCr1)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.path cannot be blank")))
-			_, err = hppCrValidator.ValidateUpdate(context.Background(), &blankPathCr2, &blankPathCr2)
+			_, err = hppCrValidator.ValidateUpdate(context.Background(), &validCr, &blankPathCr2)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.path cannot be blank")))
 		})
 		ginkgo.It("Should not allow duplicate paths", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &multiSourceVolumeDuplicatePathCR, &multiSourceVolumeDuplicatePathCR)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &multiSourceVolumeDuplicatePathCR)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("spec.storagePools[2].path is the same as spec.storagePools[0].path, cannot have duplicate paths")))
 		})
 		ginkgo.It("Should not allow duplicate names", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &multiSourceVolumeDuplicateNameCR, &multiSourceVolumeDuplicateNameCR)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &multiSourceVolumeDuplicateNameCR)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("spec.storagePools[2].name is the same as spec.storagePools[0].name, cannot have duplicate names")))
 		})
 		ginkgo.It("Should not allow storagepool.name length > 50", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &longNameCr, &longNameCr)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &longNameCr)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.name cannot have a length greater than 50")))
 		})
 		ginkgo.It("Should not allow storagepool.path length > 255", func() {
 			hppCrValidator := HostPathProvisionerValidator{}
-			_, err := hppCrValidator.ValidateUpdate(context.Background(), &longPathCr, &longPathCr)
+			_, err := hppCrValidator.ValidateUpdate(context.Background(), &validCr, &longPathCr)
 			gomega.Expect(err).To(gomega.BeEquivalentTo(fmt.Errorf("storagePool.path cannot have a length greater than 255")))
 		})
 	})
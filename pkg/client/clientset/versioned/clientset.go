@@ -19,26 +19,34 @@ limitations under the License.
 package versioned
 
 import (
-	"fmt"
-	"net/http"
+	fmt "fmt"
+	http "net/http"
 
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned/typed/hostpathprovisioner/v1"
 	hostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned/typed/hostpathprovisioner/v1beta1"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	HostpathprovisionerV1() hostpathprovisionerv1.HostpathprovisionerV1Interface
 	HostpathprovisionerV1beta1() hostpathprovisionerv1beta1.HostpathprovisionerV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	hostpathprovisionerV1      *hostpathprovisionerv1.HostpathprovisionerV1Client
 	hostpathprovisionerV1beta1 *hostpathprovisionerv1beta1.HostpathprovisionerV1beta1Client
 }
 
+// HostpathprovisionerV1 retrieves the HostpathprovisionerV1Client
+func (c *Clientset) HostpathprovisionerV1() hostpathprovisionerv1.HostpathprovisionerV1Interface {
+	return c.hostpathprovisionerV1
+}
+
 // HostpathprovisionerV1beta1 retrieves the HostpathprovisionerV1beta1Client
 func (c *Clientset) HostpathprovisionerV1beta1() hostpathprovisionerv1beta1.HostpathprovisionerV1beta1Interface {
 	return c.hostpathprovisionerV1beta1
@@ -88,6 +96,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.hostpathprovisionerV1, err = hostpathprovisionerv1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.hostpathprovisionerV1beta1, err = hostpathprovisionerv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -113,6 +125,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.hostpathprovisionerV1 = hostpathprovisionerv1.New(c)
 	cs.hostpathprovisionerV1beta1 = hostpathprovisionerv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
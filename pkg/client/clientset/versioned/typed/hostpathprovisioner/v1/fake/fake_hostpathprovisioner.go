@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1"
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned/typed/hostpathprovisioner/v1"
+)
+
+// fakeHostPathProvisioners implements HostPathProvisionerInterface
+type fakeHostPathProvisioners struct {
+	*gentype.FakeClientWithList[*v1.HostPathProvisioner, *v1.HostPathProvisionerList]
+	Fake *FakeHostpathprovisionerV1
+}
+
+func newFakeHostPathProvisioners(fake *FakeHostpathprovisionerV1) hostpathprovisionerv1.HostPathProvisionerInterface {
+	return &fakeHostPathProvisioners{
+		gentype.NewFakeClientWithList[*v1.HostPathProvisioner, *v1.HostPathProvisionerList](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("hostpathprovisioners"),
+			v1.SchemeGroupVersion.WithKind("HostPathProvisioner"),
+			func() *v1.HostPathProvisioner { return &v1.HostPathProvisioner{} },
+			func() *v1.HostPathProvisionerList { return &v1.HostPathProvisionerList{} },
+			func(dst, src *v1.HostPathProvisionerList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.HostPathProvisionerList) []*v1.HostPathProvisioner {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.HostPathProvisionerList, items []*v1.HostPathProvisioner) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1"
+	scheme "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned/scheme"
+)
+
+// HostPathProvisionersGetter has a method to return a HostPathProvisionerInterface.
+// A group's client should implement this interface.
+type HostPathProvisionersGetter interface {
+	HostPathProvisioners() HostPathProvisionerInterface
+}
+
+// HostPathProvisionerInterface has methods to work with HostPathProvisioner resources.
+type HostPathProvisionerInterface interface {
+	Create(ctx context.Context, hostPathProvisioner *hostpathprovisionerv1.HostPathProvisioner, opts metav1.CreateOptions) (*hostpathprovisionerv1.HostPathProvisioner, error)
+	Update(ctx context.Context, hostPathProvisioner *hostpathprovisionerv1.HostPathProvisioner, opts metav1.UpdateOptions) (*hostpathprovisionerv1.HostPathProvisioner, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, hostPathProvisioner *hostpathprovisionerv1.HostPathProvisioner, opts metav1.UpdateOptions) (*hostpathprovisionerv1.HostPathProvisioner, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*hostpathprovisionerv1.HostPathProvisioner, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*hostpathprovisionerv1.HostPathProvisionerList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *hostpathprovisionerv1.HostPathProvisioner, err error)
+	HostPathProvisionerExpansion
+}
+
+// hostPathProvisioners implements HostPathProvisionerInterface
+type hostPathProvisioners struct {
+	*gentype.ClientWithList[*hostpathprovisionerv1.HostPathProvisioner, *hostpathprovisionerv1.HostPathProvisionerList]
+}
+
+// newHostPathProvisioners returns a HostPathProvisioners
+func newHostPathProvisioners(c *HostpathprovisionerV1Client) *hostPathProvisioners {
+	return &hostPathProvisioners{
+		gentype.NewClientWithList[*hostpathprovisionerv1.HostPathProvisioner, *hostpathprovisionerv1.HostPathProvisionerList](
+			"hostpathprovisioners",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *hostpathprovisionerv1.HostPathProvisioner { return &hostpathprovisionerv1.HostPathProvisioner{} },
+			func() *hostpathprovisionerv1.HostPathProvisionerList {
+				return &hostpathprovisionerv1.HostPathProvisionerList{}
+			},
+		),
+	}
+}
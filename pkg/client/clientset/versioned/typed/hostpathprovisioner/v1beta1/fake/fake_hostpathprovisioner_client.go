@@ -29,7 +29,11 @@ type FakeHostpathprovisionerV1beta1 struct {
 }
 
 func (c *FakeHostpathprovisionerV1beta1) HostPathProvisioners() v1beta1.HostPathProvisionerInterface {
-	return &FakeHostPathProvisioners{c}
+	return newFakeHostPathProvisioners(c)
+}
+
+func (c *FakeHostpathprovisionerV1beta1) NodeStoragePools() v1beta1.NodeStoragePoolInterface {
+	return newFakeNodeStoragePools(c)
 }
 
 // RESTClient returns a RESTClient that is used to communicate
@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	hostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned/typed/hostpathprovisioner/v1beta1"
+)
+
+// fakeNodeStoragePools implements NodeStoragePoolInterface
+type fakeNodeStoragePools struct {
+	*gentype.FakeClientWithList[*v1beta1.NodeStoragePool, *v1beta1.NodeStoragePoolList]
+	Fake *FakeHostpathprovisionerV1beta1
+}
+
+func newFakeNodeStoragePools(fake *FakeHostpathprovisionerV1beta1) hostpathprovisionerv1beta1.NodeStoragePoolInterface {
+	return &fakeNodeStoragePools{
+		gentype.NewFakeClientWithList[*v1beta1.NodeStoragePool, *v1beta1.NodeStoragePoolList](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("nodestoragepools"),
+			v1beta1.SchemeGroupVersion.WithKind("NodeStoragePool"),
+			func() *v1beta1.NodeStoragePool { return &v1beta1.NodeStoragePool{} },
+			func() *v1beta1.NodeStoragePoolList { return &v1beta1.NodeStoragePoolList{} },
+			func(dst, src *v1beta1.NodeStoragePoolList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.NodeStoragePoolList) []*v1beta1.NodeStoragePool {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.NodeStoragePoolList, items []*v1beta1.NodeStoragePool) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -19,14 +19,13 @@ limitations under the License.
 package v1beta1
 
 import (
-	"context"
-	"time"
+	context "context"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
-	rest "k8s.io/client-go/rest"
-	v1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+	hostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 	scheme "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned/scheme"
 )
 
@@ -38,147 +37,38 @@ type HostPathProvisionersGetter interface {
 
 // HostPathProvisionerInterface has methods to work with HostPathProvisioner resources.
 type HostPathProvisionerInterface interface {
-	Create(ctx context.Context, hostPathProvisioner *v1beta1.HostPathProvisioner, opts v1.CreateOptions) (*v1beta1.HostPathProvisioner, error)
-	Update(ctx context.Context, hostPathProvisioner *v1beta1.HostPathProvisioner, opts v1.UpdateOptions) (*v1beta1.HostPathProvisioner, error)
-	UpdateStatus(ctx context.Context, hostPathProvisioner *v1beta1.HostPathProvisioner, opts v1.UpdateOptions) (*v1beta1.HostPathProvisioner, error)
+	Create(ctx context.Context, hostPathProvisioner *hostpathprovisionerv1beta1.HostPathProvisioner, opts v1.CreateOptions) (*hostpathprovisionerv1beta1.HostPathProvisioner, error)
+	Update(ctx context.Context, hostPathProvisioner *hostpathprovisionerv1beta1.HostPathProvisioner, opts v1.UpdateOptions) (*hostpathprovisionerv1beta1.HostPathProvisioner, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, hostPathProvisioner *hostpathprovisionerv1beta1.HostPathProvisioner, opts v1.UpdateOptions) (*hostpathprovisionerv1beta1.HostPathProvisioner, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
-	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.HostPathProvisioner, error)
-	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.HostPathProvisionerList, error)
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*hostpathprovisionerv1beta1.HostPathProvisioner, error)
+	List(ctx context.Context, opts v1.ListOptions) (*hostpathprovisionerv1beta1.HostPathProvisionerList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
-	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.HostPathProvisioner, err error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *hostpathprovisionerv1beta1.HostPathProvisioner, err error)
 	HostPathProvisionerExpansion
 }
 
 // hostPathProvisioners implements HostPathProvisionerInterface
 type hostPathProvisioners struct {
-	client rest.Interface
+	*gentype.ClientWithList[*hostpathprovisionerv1beta1.HostPathProvisioner, *hostpathprovisionerv1beta1.HostPathProvisionerList]
 }
 
 // newHostPathProvisioners returns a HostPathProvisioners
 func newHostPathProvisioners(c *HostpathprovisionerV1beta1Client) *hostPathProvisioners {
 	return &hostPathProvisioners{
-		client: c.RESTClient(),
+		gentype.NewClientWithList[*hostpathprovisionerv1beta1.HostPathProvisioner, *hostpathprovisionerv1beta1.HostPathProvisionerList](
+			"hostpathprovisioners",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *hostpathprovisionerv1beta1.HostPathProvisioner {
+				return &hostpathprovisionerv1beta1.HostPathProvisioner{}
+			},
+			func() *hostpathprovisionerv1beta1.HostPathProvisionerList {
+				return &hostpathprovisionerv1beta1.HostPathProvisionerList{}
+			},
+		),
 	}
 }
-
-// Get takes name of the hostPathProvisioner, and returns the corresponding hostPathProvisioner object, and an error if there is any.
-func (c *hostPathProvisioners) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.HostPathProvisioner, err error) {
-	result = &v1beta1.HostPathProvisioner{}
-	err = c.client.Get().
-		Resource("hostpathprovisioners").
-		Name(name).
-		VersionedParams(&options, scheme.ParameterCodec).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// List takes label and field selectors, and returns the list of HostPathProvisioners that match those selectors.
-func (c *hostPathProvisioners) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.HostPathProvisionerList, err error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
-	result = &v1beta1.HostPathProvisionerList{}
-	err = c.client.Get().
-		Resource("hostpathprovisioners").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Watch returns a watch.Interface that watches the requested hostPathProvisioners.
-func (c *hostPathProvisioners) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return c.client.Get().
-		Resource("hostpathprovisioners").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-// Create takes the representation of a hostPathProvisioner and creates it.  Returns the server's representation of the hostPathProvisioner, and an error, if there is any.
-func (c *hostPathProvisioners) Create(ctx context.Context, hostPathProvisioner *v1beta1.HostPathProvisioner, opts v1.CreateOptions) (result *v1beta1.HostPathProvisioner, err error) {
-	result = &v1beta1.HostPathProvisioner{}
-	err = c.client.Post().
-		Resource("hostpathprovisioners").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(hostPathProvisioner).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Update takes the representation of a hostPathProvisioner and updates it. Returns the server's representation of the hostPathProvisioner, and an error, if there is any.
-func (c *hostPathProvisioners) Update(ctx context.Context, hostPathProvisioner *v1beta1.HostPathProvisioner, opts v1.UpdateOptions) (result *v1beta1.HostPathProvisioner, err error) {
-	result = &v1beta1.HostPathProvisioner{}
-	err = c.client.Put().
-		Resource("hostpathprovisioners").
-		Name(hostPathProvisioner.Name).
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(hostPathProvisioner).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// UpdateStatus was generated because the type contains a Status member.
-// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *hostPathProvisioners) UpdateStatus(ctx context.Context, hostPathProvisioner *v1beta1.HostPathProvisioner, opts v1.UpdateOptions) (result *v1beta1.HostPathProvisioner, err error) {
-	result = &v1beta1.HostPathProvisioner{}
-	err = c.client.Put().
-		Resource("hostpathprovisioners").
-		Name(hostPathProvisioner.Name).
-		SubResource("status").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(hostPathProvisioner).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Delete takes name of the hostPathProvisioner and deletes it. Returns an error if one occurs.
-func (c *hostPathProvisioners) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
-	return c.client.Delete().
-		Resource("hostpathprovisioners").
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-// DeleteCollection deletes a collection of objects.
-func (c *hostPathProvisioners) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	var timeout time.Duration
-	if listOpts.TimeoutSeconds != nil {
-		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
-	}
-	return c.client.Delete().
-		Resource("hostpathprovisioners").
-		VersionedParams(&listOpts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-// Patch applies the patch and returns the patched hostPathProvisioner.
-func (c *hostPathProvisioners) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.HostPathProvisioner, err error) {
-	result = &v1beta1.HostPathProvisioner{}
-	err = c.client.Patch(pt).
-		Resource("hostpathprovisioners").
-		Name(name).
-		SubResource(subresources...).
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(data).
-		Do(ctx).
-		Into(result)
-	return
-}
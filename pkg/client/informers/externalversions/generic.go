@@ -19,10 +19,11 @@ limitations under the License.
 package externalversions
 
 import (
-	"fmt"
+	fmt "fmt"
 
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
+	v1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1"
 	v1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 )
 
@@ -52,9 +53,17 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=hostpathprovisioner.kubevirt.io, Version=v1beta1
+	// Group=hostpathprovisioner.kubevirt.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("hostpathprovisioners"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hostpathprovisioner().V1().HostPathProvisioners().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("nodestoragepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hostpathprovisioner().V1().NodeStoragePools().Informer()}, nil
+
+		// Group=hostpathprovisioner.kubevirt.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("hostpathprovisioners"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hostpathprovisioner().V1beta1().HostPathProvisioners().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("nodestoragepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hostpathprovisioner().V1beta1().NodeStoragePools().Informer()}, nil
 
 	}
 
@@ -19,24 +19,25 @@ limitations under the License.
 package v1beta1
 
 import (
-	"context"
+	context "context"
 	time "time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
 	cache "k8s.io/client-go/tools/cache"
-	hostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	apishostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 	versioned "kubevirt.io/hostpath-provisioner-operator/pkg/client/clientset/versioned"
 	internalinterfaces "kubevirt.io/hostpath-provisioner-operator/pkg/client/informers/externalversions/internalinterfaces"
-	v1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/client/listers/hostpathprovisioner/v1beta1"
+	hostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/client/listers/hostpathprovisioner/v1beta1"
 )
 
 // HostPathProvisionerInformer provides access to a shared informer and lister for
 // HostPathProvisioners.
 type HostPathProvisionerInformer interface {
 	Informer() cache.SharedIndexInformer
-	Lister() v1beta1.HostPathProvisionerLister
+	Lister() hostpathprovisionerv1beta1.HostPathProvisionerLister
 }
 
 type hostPathProvisionerInformer struct {
@@ -48,42 +49,67 @@ type hostPathProvisionerInformer struct {
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewHostPathProvisionerInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
-	return NewFilteredHostPathProvisionerInformer(client, resyncPeriod, indexers, nil)
+	return NewHostPathProvisionerInformerWithOptions(client, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers})
 }
 
 // NewFilteredHostPathProvisionerInformer constructs a new informer for HostPathProvisioner type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewFilteredHostPathProvisionerInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
-	return cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+	return NewHostPathProvisionerInformerWithOptions(client, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers, TweakListOptions: tweakListOptions})
+}
+
+// NewHostPathProvisionerInformerWithOptions constructs a new informer for HostPathProvisioner type with additional options.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewHostPathProvisionerInformerWithOptions(client versioned.Interface, options internalinterfaces.InformerOptions) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{Group: "hostpathprovisioner.kubevirt.io", Version: "v1beta1", Resource: "hostpathprovisioners"}
+	identifier := options.InformerName.WithResource(gvr)
+	tweakListOptions := options.TweakListOptions
+	return cache.NewSharedIndexInformerWithOptions(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.HostpathprovisionerV1beta1().HostPathProvisioners().List(context.Background(), opts)
+			},
+			WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.HostpathprovisionerV1beta1().HostPathProvisioners().Watch(context.Background(), opts)
+			},
+			ListWithContextFunc: func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.HostpathprovisionerV1beta1().HostPathProvisioners().List(context.TODO(), options)
+				return client.HostpathprovisionerV1beta1().HostPathProvisioners().List(ctx, opts)
 			},
-			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+			WatchFuncWithContext: func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.HostpathprovisionerV1beta1().HostPathProvisioners().Watch(context.TODO(), options)
+				return client.HostpathprovisionerV1beta1().HostPathProvisioners().Watch(ctx, opts)
 			},
+		}, client),
+		&apishostpathprovisionerv1beta1.HostPathProvisioner{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod: options.ResyncPeriod,
+			Indexers:     options.Indexers,
+			Identifier:   identifier,
 		},
-		&hostpathprovisionerv1beta1.HostPathProvisioner{},
-		resyncPeriod,
-		indexers,
 	)
 }
 
 func (f *hostPathProvisionerInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewFilteredHostPathProvisionerInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	return NewHostPathProvisionerInformerWithOptions(client, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, InformerName: f.factory.InformerName(), TweakListOptions: f.tweakListOptions})
 }
 
 func (f *hostPathProvisionerInformer) Informer() cache.SharedIndexInformer {
-	return f.factory.InformerFor(&hostpathprovisionerv1beta1.HostPathProvisioner{}, f.defaultInformer)
+	return f.factory.InformerFor(&apishostpathprovisionerv1beta1.HostPathProvisioner{}, f.defaultInformer)
 }
 
-func (f *hostPathProvisionerInformer) Lister() v1beta1.HostPathProvisionerLister {
-	return v1beta1.NewHostPathProvisionerLister(f.Informer().GetIndexer())
+func (f *hostPathProvisionerInformer) Lister() hostpathprovisionerv1beta1.HostPathProvisionerLister {
+	return hostpathprovisionerv1beta1.NewHostPathProvisionerLister(f.Informer().GetIndexer())
 }
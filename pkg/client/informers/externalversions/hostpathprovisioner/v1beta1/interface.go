@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// HostPathProvisioners returns a HostPathProvisionerInformer.
 	HostPathProvisioners() HostPathProvisionerInformer
+	// NodeStoragePools returns a NodeStoragePoolInformer.
+	NodeStoragePools() NodeStoragePoolInformer
 }
 
 type version struct {
@@ -43,3 +45,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) HostPathProvisioners() HostPathProvisionerInformer {
 	return &hostPathProvisionerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// NodeStoragePools returns a NodeStoragePoolInformer.
+func (v *version) NodeStoragePools() NodeStoragePoolInformer {
+	return &nodeStoragePoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1"
+)
+
+// HostPathProvisionerLister helps list HostPathProvisioners.
+// All objects returned here must be treated as read-only.
+type HostPathProvisionerLister interface {
+	// List lists all HostPathProvisioners in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*hostpathprovisionerv1.HostPathProvisioner, err error)
+	// Get retrieves the HostPathProvisioner from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*hostpathprovisionerv1.HostPathProvisioner, error)
+	HostPathProvisionerListerExpansion
+}
+
+// hostPathProvisionerLister implements the HostPathProvisionerLister interface.
+type hostPathProvisionerLister struct {
+	listers.ResourceIndexer[*hostpathprovisionerv1.HostPathProvisioner]
+}
+
+// NewHostPathProvisionerLister returns a new HostPathProvisionerLister.
+func NewHostPathProvisionerLister(indexer cache.Indexer) HostPathProvisionerLister {
+	return &hostPathProvisionerLister{listers.New[*hostpathprovisionerv1.HostPathProvisioner](indexer, hostpathprovisionerv1.Resource("hostpathprovisioner"))}
+}
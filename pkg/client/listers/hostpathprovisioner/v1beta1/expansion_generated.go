@@ -21,3 +21,7 @@ package v1beta1
 // HostPathProvisionerListerExpansion allows custom methods to be added to
 // HostPathProvisionerLister.
 type HostPathProvisionerListerExpansion interface{}
+
+// NodeStoragePoolListerExpansion allows custom methods to be added to
+// NodeStoragePoolLister.
+type NodeStoragePoolListerExpansion interface{}
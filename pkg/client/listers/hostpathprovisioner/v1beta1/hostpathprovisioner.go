@@ -19,10 +19,10 @@ limitations under the License.
 package v1beta1
 
 import (
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/client-go/tools/cache"
-	v1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	hostpathprovisionerv1beta1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 )
 
 // HostPathProvisionerLister helps list HostPathProvisioners.
@@ -30,39 +30,19 @@ import (
 type HostPathProvisionerLister interface {
 	// List lists all HostPathProvisioners in the indexer.
 	// Objects returned here must be treated as read-only.
-	List(selector labels.Selector) (ret []*v1beta1.HostPathProvisioner, err error)
+	List(selector labels.Selector) (ret []*hostpathprovisionerv1beta1.HostPathProvisioner, err error)
 	// Get retrieves the HostPathProvisioner from the index for a given name.
 	// Objects returned here must be treated as read-only.
-	Get(name string) (*v1beta1.HostPathProvisioner, error)
+	Get(name string) (*hostpathprovisionerv1beta1.HostPathProvisioner, error)
 	HostPathProvisionerListerExpansion
 }
 
 // hostPathProvisionerLister implements the HostPathProvisionerLister interface.
 type hostPathProvisionerLister struct {
-	indexer cache.Indexer
+	listers.ResourceIndexer[*hostpathprovisionerv1beta1.HostPathProvisioner]
 }
 
 // NewHostPathProvisionerLister returns a new HostPathProvisionerLister.
 func NewHostPathProvisionerLister(indexer cache.Indexer) HostPathProvisionerLister {
-	return &hostPathProvisionerLister{indexer: indexer}
-}
-
-// List lists all HostPathProvisioners in the indexer.
-func (s *hostPathProvisionerLister) List(selector labels.Selector) (ret []*v1beta1.HostPathProvisioner, err error) {
-	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1beta1.HostPathProvisioner))
-	})
-	return ret, err
-}
-
-// Get retrieves the HostPathProvisioner from the index for a given name.
-func (s *hostPathProvisionerLister) Get(name string) (*v1beta1.HostPathProvisioner, error) {
-	obj, exists, err := s.indexer.GetByKey(name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(v1beta1.Resource("hostpathprovisioner"), name)
-	}
-	return obj.(*v1beta1.HostPathProvisioner), nil
+	return &hostPathProvisionerLister{listers.New[*hostpathprovisionerv1beta1.HostPathProvisioner](indexer, hostpathprovisionerv1beta1.Resource("hostpathprovisioner"))}
 }
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+const (
+	// skipCleanupAnnotation on a node skips cleaning up the storage pools on the node, for nodes that are gone for good
+	// but still registered.
+	skipCleanupAnnotation = "hostpathprovisioner.kubevirt.io/skip-storage-pool-cleanup"
+	// cleanupNodeAnnotation and cleanupStoragePoolAnnotation are set on the cleanup Job to the node and storage pool it
+	// cleans up.
+	cleanupNodeAnnotation        = "hostpathprovisioner.kubevirt.io/cleanup-node"
+	cleanupStoragePoolAnnotation = "hostpathprovisioner.kubevirt.io/cleanup-storage-pool"
+
+	// cleanupJobBackoffLimit is the number of times the cleanup container is restarted before the Job fails
+	cleanupJobBackoffLimit = int32(3)
+	// cleanupJobStartGracePeriod is added to the time a cleanup Job may run, for scheduling the pod and pulling the image
+	cleanupJobStartGracePeriod = 5 * time.Minute
+	// cleanupJobTTL is how long finished cleanup Jobs are kept before they are garbage collected. Successful Jobs are
+	// removed by the operator, failed ones are kept for inspection until they expire, or until the CR that owns them is
+	// deleted. Their failures stay in the status of the CR after they are collected.
+	cleanupJobTTL = 24 * time.Hour
+)
+
+// cleanupJobActiveDeadline returns how long the cleanup Job of the storage pool may run, every attempt can wait for
+// the unmount timeout of the pool.
+func cleanupJobActiveDeadline(storagePool *hostpathprovisionerv1.StoragePool) time.Duration {
+	return time.Duration(cleanupJobBackoffLimit+1)*getUnmountTimeout(storagePool) + cleanupJobStartGracePeriod
+}
+
+// isNodeCleanupSkipped returns true if the node is annotated to skip cleaning up its storage pools
+func isNodeCleanupSkipped(node *corev1.Node) bool {
+	return node.GetAnnotations()[skipCleanupAnnotation] == "true"
+}
+
+// isCleanupJobSkipped returns true if the node of the cleanup Job is gone, or annotated to skip the cleanup. The pod
+// of the Job cannot run on such a node, so the Job is not waited for.
+func (r *ReconcileHostPathProvisioner) isCleanupJobSkipped(job *batchv1.Job) (bool, error) {
+	nodeName := job.GetAnnotations()[cleanupNodeAnnotation]
+	if nodeName == "" {
+		return false, nil
+	}
+	return r.isCleanupNodeSkipped(nodeName)
+}
+
+// isCleanupNodeSkipped returns true if the node is gone, or annotated to skip the cleanup
+func (r *ReconcileHostPathProvisioner) isCleanupNodeSkipped(nodeName string) (bool, error) {
+	node := &corev1.Node{}
+	if err := r.client.Get(context.TODO(), client.ObjectKey{Name: nodeName}, node); err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return isNodeCleanupSkipped(node), nil
+}
+
+// cleanupTarget is the storage pool and node a cleanup Job cleans up
+type cleanupTarget struct {
+	storagePool string
+	nodeName    string
+}
+
+func getCleanupJobTarget(job *batchv1.Job) cleanupTarget {
+	return cleanupTarget{
+		storagePool: job.GetAnnotations()[cleanupStoragePoolAnnotation],
+		nodeName:    job.GetAnnotations()[cleanupNodeAnnotation],
+	}
+}
+
+// cleanupJobFailure returns the failure of the cleanup Job, or nil if it didn't fail
+func cleanupJobFailure(job *batchv1.Job) *hostpathprovisionerv1.CleanupFailure {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			target := getCleanupJobTarget(job)
+			return &hostpathprovisionerv1.CleanupFailure{
+				StoragePool: target.storagePool,
+				NodeName:    target.nodeName,
+				Message:     fmt.Sprintf("%s (%s)", condition.Message, condition.Reason),
+			}
+		}
+	}
+	return nil
+}
+
+// mergeCleanupFailures adds the failures recorded in the status of the CR to the failures of the current Jobs. The
+// Job of a recorded failure may be deleted by its TTL or by an administrator while the storage pool is still mounted,
+// so the failure is only dropped once a later cleanup succeeded, or the node is gone or skips the cleanup.
+func (r *ReconcileHostPathProvisioner) mergeCleanupFailures(cr *hostpathprovisionerv1.HostPathProvisioner, failures []hostpathprovisionerv1.CleanupFailure, succeeded map[cleanupTarget]bool) ([]hostpathprovisionerv1.CleanupFailure, error) {
+	res := append([]hostpathprovisionerv1.CleanupFailure{}, failures...)
+	for _, recorded := range cr.Status.CleanupFailures {
+		target := cleanupTarget{storagePool: recorded.StoragePool, nodeName: recorded.NodeName}
+		if succeeded[target] || slices.ContainsFunc(failures, func(failure hostpathprovisionerv1.CleanupFailure) bool {
+			return failure.StoragePool == target.storagePool && failure.NodeName == target.nodeName
+		}) {
+			continue
+		}
+		skipped, err := r.isCleanupNodeSkipped(recorded.NodeName)
+		if err != nil {
+			return nil, err
+		}
+		if !skipped {
+			res = append(res, recorded)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].StoragePool != res[j].StoragePool {
+			return res[i].StoragePool < res[j].StoragePool
+		}
+		return res[i].NodeName < res[j].NodeName
+	})
+	return res, nil
+}
+
+// reportCleanupFailures records the failed cleanups in the status and the CleanupFailed condition of the CR, and emits
+// an event when the failed cleanups change. The condition is only added once a cleanup failed, and cleared when no
+// failure is left.
+func (r *ReconcileHostPathProvisioner) reportCleanupFailures(cr *hostpathprovisionerv1.HostPathProvisioner, failures []hostpathprovisionerv1.CleanupFailure) {
+	previous := conditions.FindStatusCondition(cr.Status.Conditions, ConditionCleanupFailed)
+	if len(failures) == 0 {
+		cr.Status.CleanupFailures = nil
+		if previous != nil {
+			MarkCrCleanupSucceeded(cr)
+		}
+		return
+	}
+	cr.Status.CleanupFailures = failures
+	descriptions := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		descriptions = append(descriptions, fmt.Sprintf("storage pool %s on node %s: %s", failure.StoragePool, failure.NodeName, failure.Message))
+	}
+	message := fmt.Sprintf(cleanupFailedMessage, strings.Join(descriptions, ", "))
+	if previous == nil || previous.Status != corev1.ConditionTrue || previous.Message != message {
+		r.recorder.Event(cr, corev1.EventTypeWarning, cleanupFailed, message)
+	}
+	MarkCrCleanupFailed(cr, message)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("cleanup jobs", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+		nvmeLabels := map[string]string{"disk": "nvme"}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		drainEvents := func(recorder *record.FakeRecorder) []string {
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			return events
+		}
+
+		// deployPoolOnTwoNodes deploys a storage pool on the nvme nodes node1 and node2
+		deployPoolOnTwoNodes := func() (*hppv1.HostPathProvisioner, *ReconcileHostPathProvisioner, client.Client) {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.StoragePools[0].NodeSelector = nvmeLabels
+			cr, r, cl := createDeployedCr(cr)
+			addNodesToCluster(1, 2, cl)
+			setNodeLabels(1, 2, nvmeLabels, cl)
+			csiDs := &appsv1.DaemonSet{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			csiDs.Status.DesiredNumberScheduled = int32(2)
+			csiDs.Status.NumberAvailable = int32(2)
+			csiDs.Status.NumberReady = int32(2)
+			gomega.Expect(cl.Status().Update(context.TODO(), csiDs)).To(gomega.Succeed())
+			createCsiDsPods(1, 2, csiDs, cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			bindAllPVCs(cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			verifyDeploymentsAndPVCs(2, 2, cr, r, cl)
+			return cr, r, cl
+		}
+
+		// removePoolFromNode2 removes the label of node2, so the storage pool is cleaned up on it
+		removePoolFromNode2 := func(r *ReconcileHostPathProvisioner, cl client.Client) {
+			setNodeLabels(2, 2, nil, cl)
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		}
+
+		getCleanupJob := func(cl client.Client) (*batchv1.Job, error) {
+			job := &batchv1.Job{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: "cleanup-pool-local-node2", Namespace: testNamespace}, job)
+			return job, err
+		}
+
+		failCleanupJob := func(cl client.Client) {
+			job, err := getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			})
+			gomega.Expect(cl.Status().Update(context.TODO(), job)).To(gomega.Succeed())
+		}
+
+		annotateNode2 := func(cl client.Client) {
+			node := &corev1.Node{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "node2"}, node)).To(gomega.Succeed())
+			node.SetAnnotations(map[string]string{skipCleanupAnnotation: "true"})
+			gomega.Expect(cl.Update(context.TODO(), node)).To(gomega.Succeed())
+		}
+
+		ginkgo.It("Should create cleanup jobs owned by the CR with limits and a TTL", func() {
+			cr, r, cl := deployPoolOnTwoNodes()
+			removePoolFromNode2(r, cl)
+			job, err := getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(metav1.IsControlledBy(job, cr)).To(gomega.BeTrue())
+			gomega.Expect(job.Spec.BackoffLimit).To(gomega.Equal(ptr.To(cleanupJobBackoffLimit)))
+			gomega.Expect(job.Spec.ActiveDeadlineSeconds).To(gomega.Equal(ptr.To(int64(1500))))
+			gomega.Expect(job.Spec.TTLSecondsAfterFinished).To(gomega.Equal(ptr.To(int32(86400))))
+			gomega.Expect(job.GetAnnotations()).To(gomega.HaveKeyWithValue(cleanupNodeAnnotation, "node2"))
+			gomega.Expect(job.GetAnnotations()).To(gomega.HaveKeyWithValue(cleanupStoragePoolAnnotation, "local"))
+			gomega.Expect(job.GetLabels()).To(gomega.HaveKeyWithValue(storagePoolLabelKey, "local-hpp"))
+			gomega.Expect(job.GetLabels()).To(gomega.HaveKeyWithValue(storagePoolNodeLabelKey, "node2"))
+			gomega.Expect(job.Spec.Template.GetLabels()).ToNot(gomega.HaveKey(storagePoolLabelKey))
+		})
+
+		ginkgo.It("Should report a failed cleanup job without blocking the cleanup", func() {
+			cr, r, cl := deployPoolOnTwoNodes()
+			removePoolFromNode2(r, cl)
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+			failCleanupJob(cl)
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			condition := conditions.FindStatusCondition(cr.Status.Conditions, ConditionCleanupFailed)
+			gomega.Expect(condition).ToNot(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(corev1.ConditionTrue))
+			gomega.Expect(condition.Message).To(gomega.Equal("Unable to clean up storage pools, storage pool local on node node2: Job has reached the specified backoff limit (BackoffLimitExceeded)"))
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(cleanupFailed)))
+			_, err = getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			ginkgo.By("Reconciling again, it should not repeat the event")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(cleanupFailed)))
+
+			ginkgo.By("Skipping the cleanup of the node, it should remove the job and clear the condition")
+			annotateNode2(cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = getCleanupJob(cl)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.IsStatusConditionFalse(cr.Status.Conditions, ConditionCleanupFailed)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should keep reporting a failed cleanup after its job is deleted", func() {
+			cr, r, cl := deployPoolOnTwoNodes()
+			removePoolFromNode2(r, cl)
+			recorder := r.recorder.(*record.FakeRecorder)
+			failCleanupJob(cl)
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			drainEvents(recorder)
+			failure := hppv1.CleanupFailure{
+				StoragePool: "local",
+				NodeName:    "node2",
+				Message:     "Job has reached the specified backoff limit (BackoffLimitExceeded)",
+			}
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.CleanupFailures).To(gomega.Equal([]hppv1.CleanupFailure{failure}))
+			message := conditions.FindStatusCondition(cr.Status.Conditions, ConditionCleanupFailed).Message
+
+			ginkgo.By("Deleting the failed job, like its TTL does, it should still report the failure")
+			job, err := getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Delete(context.TODO(), job)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.CleanupFailures).To(gomega.Equal([]hppv1.CleanupFailure{failure}))
+			condition := conditions.FindStatusCondition(cr.Status.Conditions, ConditionCleanupFailed)
+			gomega.Expect(condition.Status).To(gomega.Equal(corev1.ConditionTrue))
+			gomega.Expect(condition.Message).To(gomega.Equal(message))
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(cleanupFailed)))
+
+			ginkgo.By("Cleaning up the storage pool on the node again successfully, it should clear the failure")
+			setNodeLabels(2, 2, nvmeLabels, cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			bindAllPVCs(cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			removePoolFromNode2(r, cl)
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.CleanupFailures).To(gomega.Equal([]hppv1.CleanupFailure{failure}))
+			job, err = getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			job.Status.Succeeded = 1
+			gomega.Expect(cl.Status().Update(context.TODO(), job)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.CleanupFailures).To(gomega.BeEmpty())
+			gomega.Expect(conditions.IsStatusConditionFalse(cr.Status.Conditions, ConditionCleanupFailed)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should clear a failed cleanup whose job is deleted once the node skips the cleanup", func() {
+			cr, r, cl := deployPoolOnTwoNodes()
+			removePoolFromNode2(r, cl)
+			failCleanupJob(cl)
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			job, err := getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Delete(context.TODO(), job)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.IsStatusConditionTrue(cr.Status.Conditions, ConditionCleanupFailed)).To(gomega.BeTrue())
+
+			annotateNode2(cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.CleanupFailures).To(gomega.BeEmpty())
+			gomega.Expect(conditions.IsStatusConditionFalse(cr.Status.Conditions, ConditionCleanupFailed)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should not create a cleanup job on a node that skips the cleanup", func() {
+			_, r, cl := deployPoolOnTwoNodes()
+			annotateNode2(cl)
+			removePoolFromNode2(r, cl)
+			_, err := getCleanupJob(cl)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			deployment := &appsv1.Deployment{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node2"), Namespace: testNamespace}, deployment)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should not wait for a cleanup job on a node that is gone", func() {
+			cr, r, cl := deployPoolOnTwoNodes()
+			removePoolFromNode2(r, cl)
+			_, err := getCleanupJob(cl)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			scaleClusterNodesAndDsDown(2, 2, 1, cr, r, cl)
+			_, err = getCleanupJob(cl)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+		})
+	})
+})
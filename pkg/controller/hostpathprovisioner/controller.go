@@ -27,12 +27,14 @@ import (
 	conditions "github.com/openshift/custom-resource-status/conditions/v1"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -47,6 +49,7 @@ import (
 
 	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 	"kubevirt.io/hostpath-provisioner-operator/pkg/monitoring/metrics"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
 	"kubevirt.io/hostpath-provisioner-operator/version"
 )
 
@@ -65,8 +68,11 @@ func init() {
 }
 
 const (
-	snapshotFeatureGate = "Snapshotting"
+	snapshotFeatureGate = hostpathprovisionerv1.SnapshottingFeatureGate
 	hppFinalizer        = "finalizer.delete.hostpath-provisioner"
+
+	// uninstallBlockedRequeueInterval is how often the persistent volumes blocking the uninstall are counted again
+	uninstallBlockedRequeueInterval = 30 * time.Second
 )
 
 func isErrCacheNotStarted(err error) bool {
@@ -95,10 +101,12 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	}
 
 	return &ReconcileHostPathProvisioner{
-		client:   mgr.GetClient(),
-		scheme:   mgrScheme,
-		recorder: mgr.GetEventRecorderFor("operator-controller"),
-		Log:      log,
+		client:    mgr.GetClient(),
+		apiReader: mgr.GetAPIReader(),
+		scheme:    mgrScheme,
+		recorder:  mgr.GetEventRecorderFor("operator-controller"),
+		Log:       log,
+		logLevel:  operatorLogLevel,
 	}
 }
 
@@ -110,26 +118,30 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	hppRequests := func() []reconcile.Request {
+		hppList, err := getHppList(mgr.GetClient())
+		if err != nil {
+			log.Error(err, "Error getting HPPs")
+			return nil
+		}
+		if size := len(hppList.Items); size != 1 {
+			log.Info("There should be exactly one HPP instance")
+			return nil
+		}
+
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Name: hppList.Items[0].Name,
+				},
+			},
+		}
+	}
+
 	// mapFn will be used to map reconcile requests to the HPP for resources that don't have an ownerRef
 	mapFn := handler.MapFunc(func(_ context.Context, o client.Object) []reconcile.Request {
 		if val, ok := o.GetLabels()["k8s-app"]; ok && val == MultiPurposeHostPathProvisionerName {
-			hppList, err := getHppList(mgr.GetClient())
-			if err != nil {
-				log.Error(err, "Error getting HPPs")
-				return nil
-			}
-			if size := len(hppList.Items); size != 1 {
-				log.Info("There should be exactly one HPP instance")
-				return nil
-			}
-
-			return []reconcile.Request{
-				{
-					NamespacedName: types.NamespacedName{
-						Name: hppList.Items[0].Name,
-					},
-				},
-			}
+			return hppRequests()
 		}
 		return nil
 	})
@@ -167,6 +179,18 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	err = c.Watch(source.Kind(
+		mgr.GetCache(),
+		&hostpathprovisionerv1.NodeStoragePool{},
+		handler.TypedEnqueueRequestForOwner[*hostpathprovisionerv1.NodeStoragePool](
+			mgr.GetScheme(),
+			mgr.GetRESTMapper(),
+			&hostpathprovisionerv1.HostPathProvisioner{},
+			handler.OnlyControllerOwner())))
+	if err != nil {
+		return err
+	}
+
 	err = c.Watch(source.Kind(
 		mgr.GetCache(),
 		&corev1.ServiceAccount{},
@@ -212,6 +236,15 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	if err := c.Watch(source.Kind(
+		mgr.GetCache(),
+		&storagev1.StorageClass{},
+		handler.TypedEnqueueRequestsFromMapFunc[*storagev1.StorageClass, reconcile.Request](handler.TypedMapFunc[*storagev1.StorageClass, reconcile.Request](func(ctx context.Context, o *storagev1.StorageClass) []reconcile.Request {
+			return mapFn(ctx, o)
+		})))); err != nil {
+		return err
+	}
+
 	if err := c.Watch(source.Kind(
 		mgr.GetCache(),
 		&rbacv1.ClusterRoleBinding{},
@@ -255,6 +288,76 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// The CSI external provisioner publishes the storage capacity, the objects are labeled with the driver name.
+	if err := c.Watch(source.Kind(
+		mgr.GetCache(),
+		&storagev1.CSIStorageCapacity{},
+		handler.TypedEnqueueRequestsFromMapFunc[*storagev1.CSIStorageCapacity, reconcile.Request](handler.TypedMapFunc[*storagev1.CSIStorageCapacity, reconcile.Request](func(_ context.Context, o *storagev1.CSIStorageCapacity) []reconcile.Request {
+			if o.GetLabels()[csiStorageCapacityDriverLabel] == driverName {
+				return hppRequests()
+			}
+			return nil
+		})))); err != nil {
+		return err
+	}
+
+	// Storage pools follow the nodes of the cluster, nodes joining, leaving or changing their labels reconcile the pools.
+	if err := c.Watch(source.Kind(
+		mgr.GetCache(),
+		&corev1.Node{},
+		handler.TypedEnqueueRequestsFromMapFunc[*corev1.Node, reconcile.Request](handler.TypedMapFunc[*corev1.Node, reconcile.Request](func(_ context.Context, _ *corev1.Node) []reconcile.Request {
+			return hppRequests()
+		})),
+		nodePredicate())); err != nil {
+		return err
+	}
+
+	if err := c.Watch(source.Kind(
+		mgr.GetCache(),
+		&corev1.PersistentVolumeClaim{},
+		handler.TypedEnqueueRequestsFromMapFunc[*corev1.PersistentVolumeClaim, reconcile.Request](handler.TypedMapFunc[*corev1.PersistentVolumeClaim, reconcile.Request](func(_ context.Context, _ *corev1.PersistentVolumeClaim) []reconcile.Request {
+			return hppRequests()
+		})),
+		storagePoolPVCPredicate())); err != nil {
+		return err
+	}
+
+	if err := c.Watch(source.Kind(
+		mgr.GetCache(),
+		&batchv1.Job{},
+		handler.TypedEnqueueRequestsFromMapFunc[*batchv1.Job, reconcile.Request](handler.TypedMapFunc[*batchv1.Job, reconcile.Request](func(_ context.Context, _ *batchv1.Job) []reconcile.Request {
+			return hppRequests()
+		})),
+		storagePoolJobPredicate())); err != nil {
+		return err
+	}
+
+	if err := c.Watch(source.Kind(
+		mgr.GetCache(),
+		&corev1.PersistentVolume{},
+		handler.TypedEnqueueRequestsFromMapFunc[*corev1.PersistentVolume, reconcile.Request](handler.TypedMapFunc[*corev1.PersistentVolume, reconcile.Request](func(_ context.Context, _ *corev1.PersistentVolume) []reconcile.Request {
+			return hppRequests()
+		})),
+		persistentVolumePredicate())); err != nil {
+		return err
+	}
+
+	if used, err := r.(*ReconcileHostPathProvisioner).checkVolumeSnapshotClassUsed(); used || isErrCacheNotStarted(err) {
+		snapshotClass := &unstructured.Unstructured{}
+		snapshotClass.SetGroupVersionKind(volumeSnapshotClassGVK)
+		if err := c.Watch(source.Kind(
+			mgr.GetCache(),
+			client.Object(snapshotClass),
+			handler.TypedEnqueueRequestsFromMapFunc[client.Object, reconcile.Request](handler.TypedMapFunc[client.Object, reconcile.Request](func(ctx context.Context, o client.Object) []reconcile.Request {
+				return mapFn(ctx, o)
+			})))); err != nil {
+			if !meta.IsNoMatchError(err) {
+				return err
+			}
+			log.Info("Not watching VolumeSnapshotClasses")
+		}
+	}
+
 	if used, err := r.(*ReconcileHostPathProvisioner).checkSCCUsed(); used || isErrCacheNotStarted(err) {
 		if err := c.Watch(source.Kind(
 			mgr.GetCache(),
@@ -307,10 +410,15 @@ var _ reconcile.Reconciler = &ReconcileHostPathProvisioner{}
 type ReconcileHostPathProvisioner struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client   client.Client
-	scheme   *runtime.Scheme
-	recorder record.EventRecorder
-	Log      logr.Logger
+	client client.Client
+	// apiReader reads objects from the apiserver, for the objects outside the namespace of the operator that the
+	// cache doesn't cover
+	apiReader client.Reader
+	scheme    *runtime.Scheme
+	recorder  record.EventRecorder
+	Log       logr.Logger
+	// logLevel is the level of the operator logger, nil if it can't be changed from the CR
+	logLevel *logLevel
 }
 
 // Reconcile reads that state of the cluster for a HostPathProvisioner object and makes changes based on the state read
@@ -351,6 +459,7 @@ func (r *ReconcileHostPathProvisioner) Reconcile(context context.Context, reques
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
+	r.reconcileOperatorLogLevel(reqLogger, cr)
 	if r.isLegacy(cr) {
 		reqLogger.Info("Detected legacy CR, Reconciling CSI and legacy controller plugin")
 	} else {
@@ -368,6 +477,25 @@ func (r *ReconcileHostPathProvisioner) Reconcile(context context.Context, reques
 	namespace := watchNamespaceFunc()
 
 	if cr.GetDeletionTimestamp() != nil {
+		if isUninstallBlockedByVolumes(cr) {
+			pvCount, err := util.CountHostPathProvisionerVolumes(context, r.client)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if pvCount > 0 {
+				reqLogger.Info("Uninstall blocked, persistent volumes still exist", "count", pvCount)
+				currentCopy := cr.DeepCopy()
+				MarkCrUninstallBlocked(cr, pvCount)
+				r.ignoreHeartBeatTimestamp(currentCopy, cr)
+				if !reflect.DeepEqual(currentCopy, cr) {
+					r.recorder.Event(cr, corev1.EventTypeWarning, uninstallBlocked, fmt.Sprintf(uninstallBlockedMessage, pvCount))
+					if err := r.client.Update(context, cr); err != nil {
+						return reconcile.Result{}, err
+					}
+				}
+				return reconcile.Result{RequeueAfter: uninstallBlockedRequeueInterval}, nil
+			}
+		}
 		if err := r.cleanDeployments(reqLogger, cr, namespace); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -394,6 +522,16 @@ func (r *ReconcileHostPathProvisioner) Reconcile(context context.Context, reques
 		if res, err := r.deleteAllRbac(reqLogger, namespace); err != nil {
 			return res, err
 		}
+		reqLogger.Info("Deleting VolumeSnapshotClasses")
+		if err := r.deleteVolumeSnapshotClasses(); err != nil {
+			reqLogger.Error(err, "Unable to delete VolumeSnapshotClasses")
+			return reconcile.Result{}, err
+		}
+		reqLogger.Info("Deleting StorageClasses")
+		if err := r.deleteStorageClasses(); err != nil {
+			reqLogger.Error(err, "Unable to delete StorageClasses")
+			return reconcile.Result{}, err
+		}
 		reqLogger.Info("Deleting CSIDriver", "CSIDriver", MultiPurposeHostPathProvisionerName)
 		if err := r.deleteCSIDriver(); err != nil {
 			reqLogger.Error(err, "Unable to delete CSIDriver")
@@ -474,7 +612,7 @@ func (r *ReconcileHostPathProvisioner) reconcileCleanup(reqLogger logr.Logger, c
 		return reconcile.Result{}, err
 	}
 	reqLogger.Info("Number of storage pool deployments still active", "count", len(spDeployments))
-	cleanupFinished, err := r.hasCleanUpFinished(namespace)
+	cleanupFinished, err := r.hasCleanUpFinished(reqLogger, cr, namespace)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -507,14 +645,45 @@ func (r *ReconcileHostPathProvisioner) reconcileStatus(_ context.Context, reqLog
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	if err := r.reconcileStoragePoolStatus(reqLogger, cr, namespace); err != nil {
+	capacities, err := r.getStoragePoolCapacities(namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	// The per node state is reported before the storage pool status, so it shows which nodes are not ready.
+	nodeStatuses, err := r.reconcileNodeStoragePools(reqLogger, cr, namespace, capacities)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	previousStoragePoolStatuses := cr.Status.StoragePoolStatuses
+	if err := r.reconcileStoragePoolStatus(reqLogger, cr, namespace, nodeStatuses); err != nil {
 		MarkCrFailedHealing(cr, "StoragePoolNotReady", err.Error())
 		return reconcile.Result{}, err
 	}
+	r.reconcileStoragePoolCapacity(cr, capacities, previousStoragePoolStatuses)
+	if err := r.reconcileStoragePoolExpansion(cr, namespace); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.reconcileStorageClassStatus(cr); err != nil {
+		return reconcile.Result{}, err
+	}
+	cr.Status.Images = r.getEffectiveImages(reqLogger, cr, namespace)
 	if !degraded && cr.Status.ObservedVersion != versionString {
 		cr.Status.ObservedVersion = versionString
 	}
-	return reconcile.Result{}, nil
+	requeueAfter, err := r.reconcileStrandedVolumes(reqLogger, cr)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	// A storage pool that is not ready doesn't fail the CR, its state is reported in the storage pool status.
+	for _, poolStatus := range cr.Status.StoragePoolStatuses {
+		if poolStatus.Phase != hostpathprovisionerv1.StoragePoolReady {
+			if requeueAfter == 0 || storagePoolNotReadyRequeueInterval < requeueAfter {
+				requeueAfter = storagePoolNotReadyRequeueInterval
+			}
+			break
+		}
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *ReconcileHostPathProvisioner) deleteAllRbac(reqLogger logr.Logger, namespace string) (reconcile.Result, error) {
@@ -612,6 +781,16 @@ func (r *ReconcileHostPathProvisioner) reconcileUpdate(reqLogger logr.Logger, cr
 		reqLogger.Error(err, "unable to create CSIDriver")
 		return res, err
 	}
+	res, err = r.reconcileStorageClasses(reqLogger, cr)
+	if err != nil {
+		reqLogger.Error(err, "unable to create StorageClasses")
+		return res, err
+	}
+	res, err = r.reconcileVolumeSnapshotClasses(reqLogger, cr)
+	if err != nil {
+		reqLogger.Error(err, "unable to create VolumeSnapshotClasses")
+		return res, err
+	}
 	res, err = r.reconcileSecurityContextConstraints(reqLogger, cr, namespace)
 	if err != nil {
 		reqLogger.Error(err, "unable to create SecurityContextConstraints")
@@ -632,11 +811,19 @@ func (r *ReconcileHostPathProvisioner) reconcileUpdate(reqLogger logr.Logger, cr
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: namespace}, daemonSetCsi); err != nil {
 		return reconcile.Result{}, err
 	}
-	if (!r.isLegacy(cr) || checkDaemonSetReady(daemonSet)) && checkDaemonSetReady(daemonSetCsi) {
+	csiDaemonSetsReady, err := r.checkCSIDaemonSetsReady(cr, namespace, daemonSetCsi)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if (!r.isLegacy(cr) || checkDaemonSetReady(daemonSet)) && csiDaemonSetsReady {
 		MarkCrHealthyMessage(cr, "Complete", "Application Available")
 		r.recorder.Event(cr, corev1.EventTypeNormal, provisionerHealthy, provisionerHealthyMessage)
 	}
-	if res, err := r.reconcileCleanup(reqLogger, cr, namespace, int(daemonSetCsi.Status.DesiredNumberScheduled)); err != nil || res.RequeueAfter == time.Second {
+	deploymentCount, err := r.desiredStoragePoolDeploymentCount(reqLogger, cr, namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if res, err := r.reconcileCleanup(reqLogger, cr, namespace, deploymentCount); err != nil || res.RequeueAfter == time.Second {
 		return res, err
 	}
 
@@ -659,7 +846,12 @@ func (r *ReconcileHostPathProvisioner) checkDegraded(logger logr.Logger, cr *hos
 		return true, err
 	}
 
-	if !((!r.isLegacy(cr) || checkDaemonSetReady(daemonSet)) && checkDaemonSetReady(daemonSetCsi)) {
+	csiDaemonSetsReady, err := r.checkCSIDaemonSetsReady(cr, namespace, daemonSetCsi)
+	if err != nil {
+		return true, err
+	}
+
+	if !((!r.isLegacy(cr) || checkDaemonSetReady(daemonSet)) && csiDaemonSetsReady) {
 		degraded = true
 	}
 
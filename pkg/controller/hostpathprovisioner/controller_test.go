@@ -32,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -77,7 +78,7 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 				Namespace: testNamespace,
 			},
 		}
-		args := getDaemonSetArgs(logf.Log.WithName("hostpath-provisioner-operator-controller-test"), testNamespace, false)
+		args := getDaemonSetArgs(logf.Log.WithName("hostpath-provisioner-operator-controller-test"), cr, testNamespace, false)
 		cr, r, cl := createDeployedCr(cr)
 		ds := &appsv1.DaemonSet{
 			ObjectMeta: metav1.ObjectMeta{
@@ -157,6 +158,7 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 		s := scheme.Scheme
 		s.AddKnownTypes(hppv1.SchemeGroupVersion, cr)
 		s.AddKnownTypes(hppv1.SchemeGroupVersion, &hppv1.HostPathProvisionerList{})
+		s.AddKnownTypes(hppv1.SchemeGroupVersion, &hppv1.NodeStoragePool{}, &hppv1.NodeStoragePoolList{})
 		promv1.AddToScheme(s)
 		secv1.Install(s)
 
@@ -165,10 +167,11 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 
 		// Create a ReconcileMemcached object with the scheme and fake client.
 		r := &ReconcileHostPathProvisioner{
-			client:   cl,
-			scheme:   s,
-			recorder: record.NewFakeRecorder(250),
-			Log:      logf.Log.WithName("hostpath-provisioner-operator-controller-test"),
+			client:    cl,
+			apiReader: cl,
+			scheme:    s,
+			recorder:  record.NewFakeRecorder(250),
+			Log:       logf.Log.WithName("hostpath-provisioner-operator-controller-test"),
 		}
 
 		// Mock request to simulate Reconcile() being called on an event for a
@@ -376,6 +379,7 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 		s := scheme.Scheme
 		s.AddKnownTypes(hppv1.SchemeGroupVersion, cr)
 		s.AddKnownTypes(hppv1.SchemeGroupVersion, &hppv1.HostPathProvisionerList{})
+		s.AddKnownTypes(hppv1.SchemeGroupVersion, &hppv1.NodeStoragePool{}, &hppv1.NodeStoragePoolList{})
 		promv1.AddToScheme(s)
 		secv1.Install(s)
 
@@ -384,10 +388,11 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 
 		// Create a ReconcileMemcached object with the scheme and fake client.
 		r := &ReconcileHostPathProvisioner{
-			client:   cl,
-			scheme:   s,
-			recorder: record.NewFakeRecorder(250),
-			Log:      logf.Log.WithName("hostpath-provisioner-operator-controller-test"),
+			client:    cl,
+			apiReader: cl,
+			scheme:    s,
+			recorder:  record.NewFakeRecorder(250),
+			Log:       logf.Log.WithName("hostpath-provisioner-operator-controller-test"),
 		}
 
 		req := reconcile.Request{
@@ -417,6 +422,56 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 		gomega.Expect(res.Requeue).To(gomega.BeFalse())
 	})
 
+	ginkgo.It("Should block deleting the CR while volumes exist if the uninstall strategy requires it", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+		cr := createStoragePoolWithTemplateCr()
+		cr.Spec.UninstallStrategy = ptr.To(hppv1.UninstallStrategyBlockUninstallIfPVsExist)
+		cr, r, cl := createDeployedCr(cr)
+		pv := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pv",
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{
+						Driver:       driverName,
+						VolumeHandle: "pv",
+					},
+				},
+			},
+		}
+		err := cl.Create(context.TODO(), pv)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		err = cl.Delete(context.TODO(), cr)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		res, err := r.Reconcile(context.TODO(), req)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(res.RequeueAfter).To(gomega.Equal(uninstallBlockedRequeueInterval))
+		cr = &hppv1.HostPathProvisioner{}
+		err = cl.Get(context.TODO(), req.NamespacedName, cr)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(HasFinalizer(cr, hppFinalizer)).To(gomega.BeTrue())
+		condition := conditions.FindStatusCondition(cr.Status.Conditions, ConditionUninstallBlocked)
+		gomega.Expect(condition).ToNot(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(corev1.ConditionTrue))
+		gomega.Expect(condition.Message).To(gomega.ContainSubstring("1 persistent volumes"))
+		verifyCreateCSIDriver(r.client)
+
+		// Once the volumes are gone the CR is removed.
+		err = cl.Delete(context.TODO(), pv)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		res, err = r.Reconcile(context.TODO(), req)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(res.RequeueAfter).To(gomega.BeZero())
+		err = cl.Get(context.TODO(), req.NamespacedName, cr)
+		gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+	})
+
 	ginkgo.It("Should update CR with FailedHealing", func() {
 		req := reconcile.Request{
 			NamespacedName: types.NamespacedName{
@@ -585,6 +640,7 @@ func createDeployedCr(cr *hppv1.HostPathProvisioner) (*hppv1.HostPathProvisioner
 	s := scheme.Scheme
 	s.AddKnownTypes(hppv1.SchemeGroupVersion, cr)
 	s.AddKnownTypes(hppv1.SchemeGroupVersion, &hppv1.HostPathProvisionerList{})
+	s.AddKnownTypes(hppv1.SchemeGroupVersion, &hppv1.NodeStoragePool{}, &hppv1.NodeStoragePoolList{})
 	promv1.AddToScheme(s)
 	secv1.Install(s)
 	ocpconfigv1.Install(s)
@@ -597,10 +653,11 @@ func createDeployedCr(cr *hppv1.HostPathProvisioner) (*hppv1.HostPathProvisioner
 
 	// Create a ReconcileMemcached object with the scheme and fake client.
 	r := &ReconcileHostPathProvisioner{
-		client:   cl,
-		scheme:   s,
-		recorder: record.NewFakeRecorder(250),
-		Log:      logf.Log.WithName("hostpath-provisioner-operator-controller-test"),
+		client:    cl,
+		apiReader: cl,
+		scheme:    s,
+		recorder:  record.NewFakeRecorder(250),
+		Log:       logf.Log.WithName("hostpath-provisioner-operator-controller-test"),
 	}
 
 	// Mock request to simulate Reconcile() being called on an event for a
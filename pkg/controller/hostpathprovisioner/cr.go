@@ -17,12 +17,49 @@ limitations under the License.
 package hostpathprovisioner
 
 import (
+	"fmt"
+	"time"
+
 	conditions "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
 
 	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
 )
 
+const (
+	// ConditionUninstallBlocked is set while the uninstall strategy prevents deleting the CR
+	ConditionUninstallBlocked conditions.ConditionType = "UninstallBlocked"
+
+	uninstallBlocked = "UninstallBlocked"
+	// uninstallBlockedMessage has the number of persistent volumes that block deleting the CR
+	uninstallBlockedMessage = "Uninstall strategy is BlockUninstallIfPVsExist, %d persistent volumes provisioned by the hostpath provisioner still exist"
+
+	// ConditionCleanupFailed is set while a Job cleaning up a storage pool on a node has failed
+	ConditionCleanupFailed conditions.ConditionType = "CleanupFailed"
+
+	cleanupFailed    = "CleanupFailed"
+	cleanupSucceeded = "CleanupSucceeded"
+	// cleanupFailedMessage has the storage pools and nodes that failed to clean up
+	cleanupFailedMessage = "Unable to clean up storage pools, %s"
+
+	// ConditionStrandedVolumes is set while persistent volumes are pinned to nodes that no longer exist
+	ConditionStrandedVolumes conditions.ConditionType = "StrandedVolumes"
+
+	strandedVolumesFound = "StrandedVolumesFound"
+	noStrandedVolumes    = "NoStrandedVolumes"
+	// strandedVolumesMessage has the number of stranded persistent volumes and their nodes
+	strandedVolumesMessage = "%d persistent volumes are pinned to nodes that no longer exist, %s"
+
+	// ConditionVolumeSnapshotClassConflict is set while volume snapshot classes of the storage pools exist that are not
+	// managed by the operator
+	ConditionVolumeSnapshotClassConflict conditions.ConditionType = "VolumeSnapshotClassConflict"
+
+	volumeSnapshotClassConflict   = "VolumeSnapshotClassConflict"
+	noVolumeSnapshotClassConflict = "NoVolumeSnapshotClassConflict"
+	// volumeSnapshotClassConflictMessage has the names of the volume snapshot classes that are not managed by the operator
+	volumeSnapshotClassConflictMessage = "VolumeSnapshotClasses already exist and are not managed by the hostpath provisioner: %s"
+)
+
 func (r *ReconcileHostPathProvisioner) isDeploying(cr *hostpathprovisionerv1.HostPathProvisioner) bool {
 	return cr.Status.ObservedVersion == ""
 }
@@ -173,3 +210,94 @@ func IsHppProgressing(cr *hostpathprovisionerv1.HostPathProvisioner) bool {
 
 	return false
 }
+
+// MarkCrUninstallBlocked marks the passed CR as blocked from uninstalling. The CR object needs to be updated by the caller afterwards.
+func MarkCrUninstallBlocked(cr *hostpathprovisionerv1.HostPathProvisioner, pvCount int) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:    ConditionUninstallBlocked,
+		Status:  corev1.ConditionTrue,
+		Reason:  uninstallBlocked,
+		Message: fmt.Sprintf(uninstallBlockedMessage, pvCount),
+	})
+}
+
+func isUninstallBlockedByVolumes(cr *hostpathprovisionerv1.HostPathProvisioner) bool {
+	return cr.Spec.UninstallStrategy != nil && *cr.Spec.UninstallStrategy == hostpathprovisionerv1.UninstallStrategyBlockUninstallIfPVsExist
+}
+
+// MarkCrCleanupFailed marks the passed CR as having failed storage pool cleanup Jobs. The CR object needs to be updated
+// by the caller afterwards.
+func MarkCrCleanupFailed(cr *hostpathprovisionerv1.HostPathProvisioner, message string) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:    ConditionCleanupFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  cleanupFailed,
+		Message: message,
+	})
+}
+
+// MarkCrCleanupSucceeded clears the CleanupFailed condition of the passed CR. The CR object needs to be updated by the
+// caller afterwards.
+func MarkCrCleanupSucceeded(cr *hostpathprovisionerv1.HostPathProvisioner) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:   ConditionCleanupFailed,
+		Status: corev1.ConditionFalse,
+		Reason: cleanupSucceeded,
+	})
+}
+
+// MarkCrStrandedVolumes marks the passed CR as having persistent volumes pinned to nodes that no longer exist. The CR
+// object needs to be updated by the caller afterwards.
+func MarkCrStrandedVolumes(cr *hostpathprovisionerv1.HostPathProvisioner, message string) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:    ConditionStrandedVolumes,
+		Status:  corev1.ConditionTrue,
+		Reason:  strandedVolumesFound,
+		Message: message,
+	})
+}
+
+// MarkCrNoStrandedVolumes clears the StrandedVolumes condition of the passed CR. The CR object needs to be updated by
+// the caller afterwards.
+func MarkCrNoStrandedVolumes(cr *hostpathprovisionerv1.HostPathProvisioner) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:   ConditionStrandedVolumes,
+		Status: corev1.ConditionFalse,
+		Reason: noStrandedVolumes,
+	})
+}
+
+// MarkCrVolumeSnapshotClassConflict sets the VolumeSnapshotClassConflict condition of the passed CR. The CR object needs
+// to be updated by the caller afterwards.
+func MarkCrVolumeSnapshotClassConflict(cr *hostpathprovisionerv1.HostPathProvisioner, message string) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:    ConditionVolumeSnapshotClassConflict,
+		Status:  corev1.ConditionTrue,
+		Reason:  volumeSnapshotClassConflict,
+		Message: message,
+	})
+}
+
+// MarkCrNoVolumeSnapshotClassConflict clears the VolumeSnapshotClassConflict condition of the passed CR. The CR object
+// needs to be updated by the caller afterwards.
+func MarkCrNoVolumeSnapshotClassConflict(cr *hostpathprovisionerv1.HostPathProvisioner) {
+	conditions.SetStatusCondition(&cr.Status.Conditions, conditions.Condition{
+		Type:   ConditionVolumeSnapshotClassConflict,
+		Status: corev1.ConditionFalse,
+		Reason: noVolumeSnapshotClassConflict,
+	})
+}
+
+func getStrandedVolumeGracePeriod(cr *hostpathprovisionerv1.HostPathProvisioner) time.Duration {
+	if cr.Spec.StrandedVolumeGracePeriod == nil {
+		return defaultStrandedVolumeGracePeriod
+	}
+	return cr.Spec.StrandedVolumeGracePeriod.Duration
+}
+
+func getStrandedVolumePolicy(cr *hostpathprovisionerv1.HostPathProvisioner) hostpathprovisionerv1.StrandedVolumePolicy {
+	if cr.Spec.StrandedVolumePolicy == nil {
+		return hostpathprovisionerv1.StrandedVolumePolicyReport
+	}
+	return *cr.Spec.StrandedVolumePolicy
+}
@@ -31,7 +31,6 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -71,6 +70,17 @@ type daemonSetArgs struct {
 	name                     string
 	verbosity                int
 	version                  string
+	nodeName                 string
+	// nodeGroup is the group of nodes of a node DaemonSet that don't match all storage pools
+	nodeGroup *storagePoolNodeGroup
+	// nodeLabels are the labels of the nodes of a node DaemonSet, the DaemonSet only uses the storage pools matching
+	// them
+	nodeLabels map[string]string
+}
+
+// isNodeDaemonSet returns true if the arguments are of a DaemonSet for some nodes, instead of the main CSI DaemonSet
+func (args *daemonSetArgs) isNodeDaemonSet() bool {
+	return args.nodeName != "" || args.nodeGroup != nil
 }
 
 // reconcileDaemonSet Reconciles the daemon set.
@@ -86,7 +96,7 @@ func (r *ReconcileHostPathProvisioner) reconcileDaemonSet(reqLogger logr.Logger,
 			return reconcile.Result{}, err
 		}
 	}
-	args := getDaemonSetArgs(reqLogger.WithName("daemonset args"), namespace, true)
+	args := getDaemonSetArgs(reqLogger.WithName("daemonset args"), cr, namespace, true)
 	if r.isLegacy(cr) {
 		// provisioner
 		args.version = cr.Status.TargetVersion
@@ -100,9 +110,12 @@ func (r *ReconcileHostPathProvisioner) reconcileDaemonSet(reqLogger logr.Logger,
 		}
 	}
 	// csi driver
-	args = getDaemonSetArgs(reqLogger.WithName("daemonset args"), namespace, false)
+	args = getDaemonSetArgs(reqLogger.WithName("daemonset args"), cr, namespace, false)
 	args.version = cr.Status.TargetVersion
-	return r.reconcileDaemonSetForSa(reqLogger, r.createCSIDaemonSetObject(cr, reqLogger, args), cr)
+	if res, err := r.reconcileDaemonSetForSa(reqLogger, r.createCSIDaemonSetObject(cr, reqLogger, args), cr); err != nil {
+		return res, err
+	}
+	return r.reconcileNodeDaemonSets(reqLogger, cr, namespace)
 }
 
 func (r *ReconcileHostPathProvisioner) reconcileDaemonSetForSa(reqLogger logr.Logger, desired *appsv1.DaemonSet, cr *hostpathprovisionerv1.HostPathProvisioner) (reconcile.Result, error) {
@@ -169,51 +182,24 @@ func (r *ReconcileHostPathProvisioner) reconcileDaemonSetForSa(reqLogger logr.Lo
 	return reconcile.Result{}, nil
 }
 
-func getDaemonSetArgs(reqLogger logr.Logger, namespace string, legacyProvisioner bool) *daemonSetArgs {
+func getDaemonSetArgs(reqLogger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, legacyProvisioner bool) *daemonSetArgs {
 	res := &daemonSetArgs{}
+	images := hostpathprovisionerv1.ComponentImages{}
+	if cr != nil && cr.Spec.Images != nil {
+		images = *cr.Spec.Images
+	}
 
 	if legacyProvisioner {
 		res.name = MultiPurposeHostPathProvisionerName
-		res.provisionerImage = os.Getenv(provisionerImageEnvVarName)
-		if res.provisionerImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", provisionerImageEnvVarName, ProvisionerImageDefault))
-			res.provisionerImage = ProvisionerImageDefault
-		}
+		res.provisionerImage = getImage(reqLogger, images.LegacyProvisioner, provisionerImageEnvVarName, ProvisionerImageDefault)
 	} else {
 		res.name = fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName)
-		res.provisionerImage = os.Getenv(csiProvisionerImageEnvVarName)
-		if res.provisionerImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", csiProvisionerImageEnvVarName, CsiProvisionerImageDefault))
-			res.provisionerImage = CsiProvisionerImageDefault
-		}
-
-		res.nodeDriverRegistrarImage = os.Getenv(nodeDriverRegistrarImageEnvVarName)
-		if res.nodeDriverRegistrarImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", nodeDriverRegistrarImageEnvVarName, CsiNodeDriverRegistrationImageDefault))
-			res.nodeDriverRegistrarImage = CsiNodeDriverRegistrationImageDefault
-		}
-
-		res.livenessProbeImage = os.Getenv(livenessProbeImageEnvVarName)
-		if res.livenessProbeImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", livenessProbeImageEnvVarName, LivenessProbeImageDefault))
-			res.livenessProbeImage = LivenessProbeImageDefault
-		}
-		res.snapshotterImage = os.Getenv(snapshotterImageEnvVarName)
-		if res.snapshotterImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", snapshotterImageEnvVarName, SnapshotterImageDefault))
-			res.snapshotterImage = SnapshotterImageDefault
-		}
-
-		res.csiProvisionerImage = os.Getenv(csiSigStorageProvisionerImageEnvVarName)
-		if res.csiProvisionerImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", csiSigStorageProvisionerImageEnvVarName, CsiSigStorageProvisionerImageDefault))
-			res.csiProvisionerImage = CsiSigStorageProvisionerImageDefault
-		}
-		res.operatorImage = os.Getenv(operatorImageEnvVarName)
-		if res.operatorImage == "" {
-			reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", operatorImageEnvVarName, OperatorImageDefault))
-			res.operatorImage = OperatorImageDefault
-		}
+		res.provisionerImage = getImage(reqLogger, images.Provisioner, csiProvisionerImageEnvVarName, CsiProvisionerImageDefault)
+		res.nodeDriverRegistrarImage = getImage(reqLogger, images.NodeDriverRegistrar, nodeDriverRegistrarImageEnvVarName, CsiNodeDriverRegistrationImageDefault)
+		res.livenessProbeImage = getImage(reqLogger, images.LivenessProbe, livenessProbeImageEnvVarName, LivenessProbeImageDefault)
+		res.snapshotterImage = getImage(reqLogger, images.CSISnapshotter, snapshotterImageEnvVarName, SnapshotterImageDefault)
+		res.csiProvisionerImage = getImage(reqLogger, images.CSIProvisioner, csiSigStorageProvisionerImageEnvVarName, CsiSigStorageProvisionerImageDefault)
+		res.operatorImage = getImage(reqLogger, images.Mounter, operatorImageEnvVarName, OperatorImageDefault)
 	}
 	res.namespace = namespace
 	verbosity := os.Getenv(verbosityEnvVarName)
@@ -227,6 +213,39 @@ func getDaemonSetArgs(reqLogger logr.Logger, namespace string, legacyProvisioner
 	return res
 }
 
+// getEffectiveImages returns the images of the components deployed for the CR
+func (r *ReconcileHostPathProvisioner) getEffectiveImages(reqLogger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string) *hostpathprovisionerv1.ComponentImages {
+	args := getDaemonSetArgs(reqLogger.WithName("daemonset args"), cr, namespace, false)
+	res := &hostpathprovisionerv1.ComponentImages{
+		Provisioner:         args.provisionerImage,
+		NodeDriverRegistrar: args.nodeDriverRegistrarImage,
+		LivenessProbe:       args.livenessProbeImage,
+		CSIProvisioner:      args.csiProvisionerImage,
+		Mounter:             args.operatorImage,
+	}
+	if r.isFeatureGateEnabled(snapshotFeatureGate, cr) {
+		res.CSISnapshotter = args.snapshotterImage
+	}
+	if r.isLegacy(cr) {
+		res.LegacyProvisioner = getDaemonSetArgs(reqLogger.WithName("daemonset args"), cr, namespace, true).provisionerImage
+	}
+	return res
+}
+
+// getImage returns the image override from the CR if set, otherwise the image from the environment variable of the
+// operator, or the default image if neither is set.
+func getImage(reqLogger logr.Logger, override, envVarName, defaultImage string) string {
+	if override != "" {
+		return override
+	}
+	image := os.Getenv(envVarName)
+	if image == "" {
+		reqLogger.V(3).Info(fmt.Sprintf("%s not set, defaulting to %s", envVarName, defaultImage))
+		return defaultImage
+	}
+	return image
+}
+
 func (r *ReconcileHostPathProvisioner) deleteDaemonSet(name, namespace string) error {
 	// Check if this DaemonSet already exists
 	ds := &appsv1.DaemonSet{
@@ -263,6 +282,7 @@ func createDaemonSetObject(cr *hostpathprovisionerv1.HostPathProvisioner, reqLog
 	volumeType := corev1.HostPathDirectoryOrCreate
 	usePrefix := getUsePrefix(cr)
 	path := getPath(cr)
+	resources := getComponentResources(cr)
 	labels := util.GetRecommendedLabels()
 	// Add NetworkPolicy label
 	labels[util.AllowAccessClusterServicesNPLabel] = "true"
@@ -286,18 +306,15 @@ func createDaemonSetObject(cr *hostpathprovisionerv1.HostPathProvisioner, reqLog
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName:            ProvisionerServiceAccountName,
+					PriorityClassName:             cr.Spec.PriorityClassName,
+					ImagePullSecrets:              cr.Spec.ImagePullSecrets,
 					RestartPolicy:                 corev1.RestartPolicyAlways,
 					DNSPolicy:                     corev1.DNSClusterFirst,
 					TerminationGracePeriodSeconds: pointer.Int64Ptr(30),
 					SecurityContext:               &corev1.PodSecurityContext{},
 					Containers: []corev1.Container{
 						{
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("150Mi"),
-								},
-							},
+							Resources:       containerResources(resources.Provisioner, defaultDaemonSetRequests()),
 							Name:            MultiPurposeHostPathProvisionerName,
 							Image:           args.provisionerImage,
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
@@ -397,7 +414,9 @@ func getPath(cr *hostpathprovisionerv1.HostPathProvisioner) string {
 	return ""
 }
 
-func getStoragePoolPaths(cr *hostpathprovisionerv1.HostPathProvisioner) []StoragePoolInfo {
+// getStoragePoolPaths returns the storage pools the CSI driver uses on the nodes of the DaemonSet. The main DaemonSet
+// only runs on the nodes that match all storage pools, a node DaemonSet only uses the storage pools matching its nodes.
+func getStoragePoolPaths(cr *hostpathprovisionerv1.HostPathProvisioner, args *daemonSetArgs) []StoragePoolInfo {
 	storagePoolPaths := make([]StoragePoolInfo, 0)
 	if cr.Spec.PathConfig != nil {
 		storagePoolPaths = append(storagePoolPaths, StoragePoolInfo{
@@ -406,6 +425,9 @@ func getStoragePoolPaths(cr *hostpathprovisionerv1.HostPathProvisioner) []Storag
 		})
 	} else if len(cr.Spec.StoragePools) > 0 {
 		for _, storagePool := range cr.Spec.StoragePools {
+			if args.isNodeDaemonSet() && !storagePoolMatchesLabels(&storagePool, args.nodeLabels) {
+				continue
+			}
 			storagePoolPaths = append(storagePoolPaths, StoragePoolInfo{
 				Name:             storagePool.Name,
 				Path:             storagePool.Path,
@@ -479,10 +501,11 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 	reqLogger.V(3).Info("CR nodeselector", "nodeselector", cr.Spec.Workload)
 	directoryOrCreate := corev1.HostPathDirectoryOrCreate
 	directory := corev1.HostPathDirectory
-	storagePoolPaths := getStoragePoolPaths(cr)
+	storagePoolPaths := getStoragePoolPaths(cr, args)
 	pathVolumes := buildVolumesFromStoragePoolInfo(storagePoolPaths)
 	pathMounts := buildVolumeMountsFromStoragePoolInfo(storagePoolPaths)
 	biDirectional := corev1.MountPropagationBidirectional
+	resources := getComponentResources(cr)
 	labels := util.GetRecommendedLabels()
 	labels[PrometheusLabelKey] = PrometheusLabelValue
 	ds := &appsv1.DaemonSet{
@@ -520,15 +543,12 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 				Spec: corev1.PodSpec{
 
 					ServiceAccountName: ProvisionerServiceAccountNameCsi,
+					PriorityClassName:  cr.Spec.PriorityClassName,
+					ImagePullSecrets:   cr.Spec.ImagePullSecrets,
 					RestartPolicy:      corev1.RestartPolicyAlways,
 					Containers: []corev1.Container{
 						{
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("150Mi"),
-								},
-							},
+							Resources:       containerResources(resources.Provisioner, defaultDaemonSetRequests()),
 							Name:            MultiPurposeHostPathProvisionerName,
 							Image:           args.provisionerImage,
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
@@ -582,7 +602,7 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 							},
 							Args: []string{
 								fmt.Sprintf("--drivername=%s", driverName),
-								fmt.Sprintf("--v=%d", args.verbosity),
+								verbosityArg(cr, args, provisionerLogComponent),
 								"--endpoint=$(CSI_ENDPOINT)",
 								"--nodeid=$(NODE_NAME)",
 								"--version=$(VERSION)",
@@ -634,17 +654,12 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 							TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 						},
 						{
-							Name: nodeDriverRegistrarName,
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("150Mi"),
-								},
-							},
+							Name:            nodeDriverRegistrarName,
+							Resources:       containerResources(resources.NodeDriverRegistrar, defaultDaemonSetRequests()),
 							Image:           args.nodeDriverRegistrarImage,
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
 							Args: []string{
-								fmt.Sprintf("--v=%d", args.verbosity),
+								verbosityArg(cr, args, nodeDriverRegistrarLogComponent),
 								fmt.Sprintf("--csi-address=%s", csiSocket),
 								"--kubelet-registration-path=/var/lib/kubelet/plugins/csi-hostpath/csi.sock",
 							},
@@ -673,12 +688,7 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 							TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 						},
 						{
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("150Mi"),
-								},
-							},
+							Resources:       containerResources(resources.LivenessProbe, defaultDaemonSetRequests()),
 							Name:            "liveness-probe",
 							Image:           args.livenessProbeImage,
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
@@ -693,17 +703,12 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 							},
 						},
 						{
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("150Mi"),
-								},
-							},
+							Resources:       containerResources(resources.CSIProvisioner, defaultDaemonSetRequests()),
 							Name:            "csi-provisioner",
 							Image:           args.csiProvisionerImage,
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
 							Args: []string{
-								fmt.Sprintf("--v=%d", args.verbosity),
+								verbosityArg(cr, args, csiProvisionerLogComponent),
 								fmt.Sprintf("--csi-address=%s", csiSocket),
 								"--feature-gates=Topology=true",
 								"--enable-capacity=true",
@@ -803,24 +808,43 @@ func (r *ReconcileHostPathProvisioner) createCSIDaemonSetObject(cr *hostpathprov
 	}
 	ds.Spec.Template.Spec.Volumes = append(ds.Spec.Template.Spec.Volumes, pathVolumes...)
 	if r.isFeatureGateEnabled(snapshotFeatureGate, cr) {
-		ds.Spec.Template.Spec.Containers = append(ds.Spec.Template.Spec.Containers, *createSnapshotSideCarContainer(args.snapshotterImage, cr.Spec.ImagePullPolicy, args.verbosity))
+		ds.Spec.Template.Spec.Containers = append(ds.Spec.Template.Spec.Containers, *createSnapshotSideCarContainer(args.snapshotterImage, cr.Spec.ImagePullPolicy, verbosityArg(cr, args, csiSnapshotterLogComponent), containerResources(resources.CSISnapshotter, nil)))
 	}
 	for i, container := range ds.Spec.Template.Spec.Containers {
 		if container.Name == MultiPurposeHostPathProvisionerName || container.Name == nodeDriverRegistrarName {
 			ds.Spec.Template.Spec.Containers[i].VolumeMounts = append(ds.Spec.Template.Spec.Containers[i].VolumeMounts, pathMounts...)
 		}
 	}
+	if args.nodeName != "" {
+		setNodeDaemonSet(ds, getNodeDaemonSetName(args.nodeName), []string{args.nodeName})
+	} else if args.nodeGroup != nil {
+		setNodeDaemonSet(ds, args.nodeGroup.daemonSetName(), args.nodeGroup.nodeNames)
+	} else {
+		if nodeNames := getLogVerbosityNodes(cr); len(nodeNames) > 0 {
+			// The nodes with their own log verbosity run the pods of their node DaemonSet instead.
+			ds.Spec.Template.Spec.Affinity = addRequiredNodeAffinity(ds.Spec.Template.Spec.Affinity, nil, []corev1.NodeSelectorRequirement{
+				{
+					Key:      metav1.ObjectNameField,
+					Operator: corev1.NodeSelectorOpNotIn,
+					Values:   nodeNames,
+				},
+			})
+		}
+		// The nodes that don't match all storage pools run the pods of the DaemonSet of their storage pool node group.
+		ds.Spec.Template.Spec.Affinity = addRequiredNodeAffinity(ds.Spec.Template.Spec.Affinity, getStoragePoolNodeRequirements(cr), nil)
+	}
 
 	return ds
 }
 
-func createSnapshotSideCarContainer(image string, pullPolicy corev1.PullPolicy, verbosity int) *corev1.Container {
+func createSnapshotSideCarContainer(image string, pullPolicy corev1.PullPolicy, verbosityArg string, resources corev1.ResourceRequirements) *corev1.Container {
 	return &corev1.Container{
 		Name:            "csi-snapshotter",
 		Image:           image,
 		ImagePullPolicy: pullPolicy,
+		Resources:       resources,
 		Args: []string{
-			fmt.Sprintf("--v=%d", verbosity),
+			verbosityArg,
 			fmt.Sprintf("--csi-address=%s", csiSocket),
 			"--leader-election",
 		},
@@ -849,6 +873,9 @@ func (r *ReconcileHostPathProvisioner) getDuplicateDaemonSet(customCrName, names
 	}
 
 	for _, ds := range dsList.Items {
+		if _, ok := ds.GetLabels()[nodeDaemonSetLabel]; ok {
+			continue
+		}
 		if ds.Name != MultiPurposeHostPathProvisionerName && ds.Name != fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName) {
 			for _, ownerRef := range ds.OwnerReferences {
 				if ownerRef.Kind == "HostPathProvisioner" && ownerRef.Name == customCrName {
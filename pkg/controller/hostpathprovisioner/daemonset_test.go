@@ -24,10 +24,15 @@ import (
 	ginkgo "github.com/onsi/ginkgo/v2"
 	gomega "github.com/onsi/gomega"
 	secv1 "github.com/openshift/api/security/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -479,6 +484,276 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 			ginkgo.Entry("csiDs", fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName)),
 		)
 
+		ginkgo.It("Should apply the configured resources to the CSI daemonset containers", func() {
+			cr := createLegacyCr()
+			cr.Spec.Resources = &hppv1.ComponentResources{
+				Provisioner: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("200m"),
+						corev1.ResourceMemory: resource.MustParse("100Mi"),
+					},
+				},
+				NodeDriverRegistrar: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("20Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+			}
+			cr, r, cl := createDeployedCr(cr)
+			getContainers := func() map[string]corev1.Container {
+				ds := &appsv1.DaemonSet{}
+				err := cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, ds)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				containers := make(map[string]corev1.Container)
+				for _, container := range ds.Spec.Template.Spec.Containers {
+					containers[container.Name] = container
+				}
+				return containers
+			}
+			containers := getContainers()
+			gomega.Expect(containers[MultiPurposeHostPathProvisionerName].Resources).To(gomega.Equal(corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10m"),
+					corev1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("200m"),
+					corev1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+			}))
+			gomega.Expect(containers[nodeDriverRegistrarName].Resources).To(gomega.Equal(*cr.Spec.Resources.NodeDriverRegistrar))
+			gomega.Expect(containers["liveness-probe"].Resources).To(gomega.Equal(corev1.ResourceRequirements{
+				Requests: defaultDaemonSetRequests(),
+			}))
+
+			ginkgo.By("Removing the configured resources, the defaults should be restored")
+			err := cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			cr.Spec.Resources = nil
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			containers = getContainers()
+			gomega.Expect(containers[MultiPurposeHostPathProvisionerName].Resources).To(gomega.Equal(corev1.ResourceRequirements{
+				Requests: defaultDaemonSetRequests(),
+			}))
+			gomega.Expect(containers[nodeDriverRegistrarName].Resources).To(gomega.Equal(corev1.ResourceRequirements{
+				Requests: defaultDaemonSetRequests(),
+			}))
+		})
+
+		ginkgo.It("Should apply the priority class, image pull secrets and image overrides", func() {
+			cr := createLegacyCr()
+			cr.Spec.PriorityClassName = "system-node-critical"
+			cr.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "mirror-pull-secret"}}
+			cr, r, cl := createDeployedCr(cr)
+			for _, name := range []string{MultiPurposeHostPathProvisionerName, fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName)} {
+				ds := &appsv1.DaemonSet{}
+				err := cl.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: testNamespace}, ds)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(ds.Spec.Template.Spec.PriorityClassName).To(gomega.Equal("system-node-critical"))
+				gomega.Expect(ds.Spec.Template.Spec.ImagePullSecrets).To(gomega.Equal(cr.Spec.ImagePullSecrets))
+			}
+
+			ginkgo.By("Overriding the images in the CR")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.Images = &hppv1.ComponentImages{
+				Provisioner:       "mirror.example.com/hostpath-provisioner-csi:hotfix",
+				LegacyProvisioner: "mirror.example.com/hostpath-provisioner:hotfix",
+				LivenessProbe:     "mirror.example.com/livenessprobe:v2.3.0",
+			}
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			ds := &appsv1.DaemonSet{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: MultiPurposeHostPathProvisionerName, Namespace: testNamespace}, ds)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ds.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal("mirror.example.com/hostpath-provisioner:hotfix"))
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, ds)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			images := make(map[string]string)
+			for _, container := range ds.Spec.Template.Spec.Containers {
+				images[container.Name] = container.Image
+			}
+			gomega.Expect(images).To(gomega.Equal(map[string]string{
+				MultiPurposeHostPathProvisionerName: "mirror.example.com/hostpath-provisioner-csi:hotfix",
+				nodeDriverRegistrarName:             CsiNodeDriverRegistrationImageDefault,
+				"liveness-probe":                    "mirror.example.com/livenessprobe:v2.3.0",
+				"csi-provisioner":                   CsiSigStorageProvisionerImageDefault,
+			}))
+
+			ginkgo.By("Reporting the effective images in the status")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.Images).To(gomega.Equal(&hppv1.ComponentImages{
+				Provisioner:         "mirror.example.com/hostpath-provisioner-csi:hotfix",
+				LegacyProvisioner:   "mirror.example.com/hostpath-provisioner:hotfix",
+				NodeDriverRegistrar: CsiNodeDriverRegistrationImageDefault,
+				LivenessProbe:       "mirror.example.com/livenessprobe:v2.3.0",
+				CSIProvisioner:      CsiSigStorageProvisionerImageDefault,
+				Mounter:             OperatorImageDefault,
+			}))
+		})
+
+		ginkgo.It("Should apply the component and node log verbosity", func() {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.LogVerbosity = &hppv1.LogVerbosity{
+				Default: ptr.To[int32](2),
+				Components: &hppv1.ComponentLogVerbosity{
+					CSIProvisioner: ptr.To[int32](5),
+				},
+				Nodes: []hppv1.NodeLogVerbosity{
+					{
+						NodeName: "node1",
+						Default:  ptr.To[int32](7),
+						Components: &hppv1.ComponentLogVerbosity{
+							NodeDriverRegistrar: ptr.To[int32](4),
+						},
+					},
+				},
+			}
+			cr, r, cl := createDeployedCr(cr)
+			verbosityArgs := func(ds *appsv1.DaemonSet) map[string]string {
+				res := make(map[string]string)
+				for _, container := range ds.Spec.Template.Spec.Containers {
+					for _, arg := range container.Args {
+						if strings.HasPrefix(arg, "--v=") {
+							res[container.Name] = arg
+						}
+					}
+				}
+				return res
+			}
+			ds := &appsv1.DaemonSet{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, ds)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(verbosityArgs(ds)).To(gomega.Equal(map[string]string{
+				MultiPurposeHostPathProvisionerName: "--v=2",
+				nodeDriverRegistrarName:             "--v=2",
+				"csi-provisioner":                   "--v=5",
+			}))
+			gomega.Expect(ds.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(gomega.Equal([]corev1.NodeSelectorTerm{
+				{
+					MatchFields: []corev1.NodeSelectorRequirement{
+						{
+							Key:      metav1.ObjectNameField,
+							Operator: corev1.NodeSelectorOpNotIn,
+							Values:   []string{"node1"},
+						},
+					},
+				},
+			}))
+
+			ginkgo.By("Running the pods of the overridden node in a node DaemonSet")
+			nodeDs := &appsv1.DaemonSet{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: getNodeDaemonSetName("node1"), Namespace: testNamespace}, nodeDs)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(verbosityArgs(nodeDs)).To(gomega.Equal(map[string]string{
+				MultiPurposeHostPathProvisionerName: "--v=7",
+				nodeDriverRegistrarName:             "--v=4",
+				"csi-provisioner":                   "--v=7",
+			}))
+			gomega.Expect(nodeDs.Spec.Selector.MatchLabels).To(gomega.Equal(map[string]string{
+				"k8s-app":          MultiPurposeHostPathProvisionerName,
+				nodeDaemonSetLabel: nodeDs.GetName(),
+			}))
+			gomega.Expect(nodeDs.Spec.Template.GetLabels()).To(gomega.HaveKeyWithValue(nodeDaemonSetLabel, nodeDs.GetName()))
+			gomega.Expect(nodeDs.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(gomega.Equal([]corev1.NodeSelectorTerm{
+				{
+					MatchFields: []corev1.NodeSelectorRequirement{
+						{
+							Key:      metav1.ObjectNameField,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{"node1"},
+						},
+					},
+				},
+			}))
+
+			ginkgo.By("Removing the node override")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.LogVerbosity.Nodes = nil
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(nodeDs), nodeDs)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(ds), ds)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ds.Spec.Template.Spec.Affinity).To(gomega.BeNil())
+		})
+
+		ginkgo.It("Should keep the node affinity of the workload on the node DaemonSets", func() {
+			affinity := &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "node-role.kubernetes.io/worker",
+										Operator: corev1.NodeSelectorOpExists,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			res := addRequiredNodeAffinity(affinity, nil, []corev1.NodeSelectorRequirement{
+				{
+					Key:      metav1.ObjectNameField,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{"node1"},
+				},
+			})
+			gomega.Expect(res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(gomega.HaveLen(1))
+			term := res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0]
+			gomega.Expect(term.MatchExpressions).To(gomega.HaveLen(1))
+			gomega.Expect(term.MatchFields).To(gomega.HaveLen(1))
+
+			ginkgo.By("Adding node label expressions, it should add them to the existing ones")
+			res = addRequiredNodeAffinity(res, []corev1.NodeSelectorRequirement{
+				{
+					Key:      "zone",
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{"a"},
+				},
+			}, nil)
+			term = res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0]
+			gomega.Expect(term.MatchExpressions).To(gomega.HaveLen(2))
+			gomega.Expect(term.MatchFields).To(gomega.HaveLen(1))
+			gomega.Expect(addRequiredNodeAffinity(affinity, nil, nil)).To(gomega.BeIdenticalTo(affinity))
+			gomega.Expect(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchFields).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should change the operator log level from the CR", func() {
+			cr, r, cl := createDeployedCr(createLegacyCr())
+			level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+			r.logLevel = &logLevel{
+				level:        level,
+				initialLevel: zapcore.InfoLevel,
+			}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.LogVerbosity = &hppv1.LogVerbosity{
+				Operator: ptr.To[int32](3),
+			}
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(level.Level()).To(gomega.Equal(zapcore.Level(-3)))
+
+			ginkgo.By("Restoring the initial level when the verbosity is removed")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.LogVerbosity = nil
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(level.Level()).To(gomega.Equal(zapcore.InfoLevel))
+		})
+
 		ginkgo.Context("metrics TLS configuration", func() {
 			ginkgo.BeforeEach(func() {
 				// Clear env vars to ensure deterministic test behavior
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+const (
+	encryptionKeyVolumeName = "encryption-key"
+	encryptionKeyMountPath  = "/etc/hpp/encryption"
+)
+
+// getLuksName returns the name of the opened LUKS device of an encrypted storage pool on the host
+func getLuksName(storagePool *hostpathprovisionerv1.StoragePool) string {
+	return getResourceNameWithMaxLength(hppPoolPrefix, storagePool.Name, maxNameLength)
+}
+
+func getEncryptionSecretKey(encryption *hostpathprovisionerv1.StoragePoolEncryption) string {
+	if encryption.Key == "" {
+		return hostpathprovisionerv1.DefaultEncryptionSecretKey
+	}
+	return encryption.Key
+}
+
+// addEncryptionKey mounts the Secret holding the encryption key of the storage pool in the mounter container, and
+// passes the LUKS device name and key file to the mounter.
+func addEncryptionKey(podSpec *corev1.PodSpec, storagePool *hostpathprovisionerv1.StoragePool) {
+	if storagePool.Encryption == nil {
+		return
+	}
+	key := getEncryptionSecretKey(storagePool.Encryption)
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: encryptionKeyVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: storagePool.Encryption.SecretName,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  key,
+						Path: key,
+					},
+				},
+				DefaultMode: pointer.Int32(0400),
+			},
+		},
+	})
+	container := &podSpec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      encryptionKeyVolumeName,
+		MountPath: encryptionKeyMountPath,
+		ReadOnly:  true,
+	})
+	container.Command = append(container.Command,
+		"--luksName", getLuksName(storagePool),
+		"--encryptionKeyFile", filepath.Join(encryptionKeyMountPath, key),
+	)
+}
+
+// addEncryptionCleanup passes the LUKS device name of the storage pool to the cleanup Job, so the device is closed
+// after it is unmounted. The key is not needed to close the device.
+func addEncryptionCleanup(podSpec *corev1.PodSpec, storagePool *hostpathprovisionerv1.StoragePool) {
+	if storagePool.Encryption == nil {
+		return
+	}
+	podSpec.Containers[0].Command = append(podSpec.Containers[0].Command, "--luksName", getLuksName(storagePool))
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+const (
+	// nodeDaemonSetLabel is the label of the CSI DaemonSets that only run on some nodes, a node with its own log
+	// verbosity or a storage pool node group, and of their pods. The value is the name of the DaemonSet.
+	nodeDaemonSetLabel = "hostpathprovisioner.kubevirt.io/node-daemonset"
+)
+
+// logComponent identifies a component in the log verbosity of the CR
+type logComponent string
+
+const (
+	provisionerLogComponent         logComponent = "provisioner"
+	nodeDriverRegistrarLogComponent logComponent = "nodeDriverRegistrar"
+	csiProvisionerLogComponent      logComponent = "csiProvisioner"
+	csiSnapshotterLogComponent      logComponent = "csiSnapshotter"
+	mounterLogComponent             logComponent = "mounter"
+)
+
+// operatorLogLevel is the level of the operator logger, it is nil if the logger level cannot be changed
+var operatorLogLevel *logLevel
+
+// logLevel is a log level that can be changed at runtime, and the level it started with.
+type logLevel struct {
+	level        zap.AtomicLevel
+	initialLevel zapcore.Level
+}
+
+// SetOperatorLogLevel sets the level of the operator logger, so it can be changed from the CR. It has to be called
+// before the controller is added to the manager.
+func SetOperatorLogLevel(level zap.AtomicLevel) {
+	operatorLogLevel = &logLevel{
+		level:        level,
+		initialLevel: level.Level(),
+	}
+}
+
+// reconcileOperatorLogLevel sets the operator log level to the verbosity of the CR, or back to the initial level if
+// the CR has none.
+func (r *ReconcileHostPathProvisioner) reconcileOperatorLogLevel(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner) {
+	if r.logLevel == nil {
+		return
+	}
+	desired := r.logLevel.initialLevel
+	if cr.Spec.LogVerbosity != nil && cr.Spec.LogVerbosity.Operator != nil {
+		// logr verbosity levels are negative zap levels
+		desired = zapcore.Level(-*cr.Spec.LogVerbosity.Operator)
+	}
+	if r.logLevel.level.Level() != desired {
+		logger.Info("Changing the operator log level", "level", desired.String())
+		r.logLevel.level.SetLevel(desired)
+	}
+}
+
+// getLogVerbosity returns the verbosity of a component on a node, the node name is empty for components that are not
+// specific to a node. It returns nil if the CR doesn't set the verbosity of the component.
+func getLogVerbosity(cr *hostpathprovisionerv1.HostPathProvisioner, nodeName string, component logComponent) *int32 {
+	logVerbosity := cr.Spec.LogVerbosity
+	if logVerbosity == nil {
+		return nil
+	}
+	if nodeName != "" {
+		for _, node := range logVerbosity.Nodes {
+			if node.NodeName != nodeName {
+				continue
+			}
+			if verbosity := componentLogVerbosity(node.Components, component); verbosity != nil {
+				return verbosity
+			}
+			if node.Default != nil {
+				return node.Default
+			}
+		}
+	}
+	if verbosity := componentLogVerbosity(logVerbosity.Components, component); verbosity != nil {
+		return verbosity
+	}
+	return logVerbosity.Default
+}
+
+func componentLogVerbosity(components *hostpathprovisionerv1.ComponentLogVerbosity, component logComponent) *int32 {
+	if components == nil {
+		return nil
+	}
+	switch component {
+	case provisionerLogComponent:
+		return components.Provisioner
+	case nodeDriverRegistrarLogComponent:
+		return components.NodeDriverRegistrar
+	case csiProvisionerLogComponent:
+		return components.CSIProvisioner
+	case csiSnapshotterLogComponent:
+		return components.CSISnapshotter
+	case mounterLogComponent:
+		return components.Mounter
+	}
+	return nil
+}
+
+// verbosityArg returns the klog verbosity argument of a DaemonSet container, using the verbosity of the operator
+// environment if the CR doesn't set one.
+func verbosityArg(cr *hostpathprovisionerv1.HostPathProvisioner, args *daemonSetArgs, component logComponent) string {
+	if verbosity := getLogVerbosity(cr, args.nodeName, component); verbosity != nil {
+		return fmt.Sprintf("--v=%d", *verbosity)
+	}
+	return fmt.Sprintf("--v=%d", args.verbosity)
+}
+
+// mounterLogArgs returns the zap log level arguments of the mounter on a node, empty if the CR doesn't set the
+// verbosity of the mounter.
+func mounterLogArgs(cr *hostpathprovisionerv1.HostPathProvisioner, nodeName string) []string {
+	verbosity := getLogVerbosity(cr, nodeName, mounterLogComponent)
+	if verbosity == nil {
+		return nil
+	}
+	// The zap level flag takes info for verbosity 0, and the debug verbosity for higher levels.
+	if *verbosity == 0 {
+		return []string{"--zap-log-level=info"}
+	}
+	return []string{fmt.Sprintf("--zap-log-level=%d", *verbosity)}
+}
+
+// getLogVerbosityNodes returns the sorted names of the nodes that override the verbosity of the components
+func getLogVerbosityNodes(cr *hostpathprovisionerv1.HostPathProvisioner) []string {
+	res := make([]string, 0)
+	if cr.Spec.LogVerbosity == nil {
+		return res
+	}
+	for _, node := range cr.Spec.LogVerbosity.Nodes {
+		res = append(res, node.NodeName)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// reconcileNodeDaemonSets creates a CSI DaemonSet for each node with its own log verbosity, and for each group of nodes
+// that don't match all storage pools, and deletes the node DaemonSets that are no longer needed. A node that moves to
+// another DaemonSet gets its CSI driver pod recreated by that DaemonSet, it is not rolled out.
+func (r *ReconcileHostPathProvisioner) reconcileNodeDaemonSets(reqLogger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string) (reconcile.Result, error) {
+	desired := make(map[string]struct{})
+	for _, nodeName := range getLogVerbosityNodes(cr) {
+		nodeLabels, err := r.getNodeLabels(nodeName)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		args := getDaemonSetArgs(reqLogger.WithName("daemonset args"), cr, namespace, false)
+		args.version = cr.Status.TargetVersion
+		args.nodeName = nodeName
+		args.nodeLabels = nodeLabels
+		ds := r.createCSIDaemonSetObject(cr, reqLogger, args)
+		desired[ds.GetName()] = struct{}{}
+		if res, err := r.reconcileDaemonSetForSa(reqLogger, ds, cr); err != nil {
+			return res, err
+		}
+	}
+	groups, err := r.getStoragePoolNodeGroups(cr)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	for _, group := range groups {
+		args := getDaemonSetArgs(reqLogger.WithName("daemonset args"), cr, namespace, false)
+		args.version = cr.Status.TargetVersion
+		args.nodeGroup = group
+		args.nodeLabels = group.nodeLabels
+		ds := r.createCSIDaemonSetObject(cr, reqLogger, args)
+		desired[ds.GetName()] = struct{}{}
+		if res, err := r.reconcileDaemonSetForSa(reqLogger, ds, cr); err != nil {
+			return res, err
+		}
+	}
+	current, err := r.listNodeDaemonSets(namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	for _, ds := range current {
+		if _, ok := desired[ds.GetName()]; ok || !metav1.IsControlledBy(&ds, cr) {
+			continue
+		}
+		reqLogger.Info("Deleting unused node DaemonSet", "DaemonSet.Name", ds.GetName())
+		if err := r.client.Delete(context.TODO(), &ds); err != nil && !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// getNodeLabels returns the labels of the node, nil if the node doesn't exist
+func (r *ReconcileHostPathProvisioner) getNodeLabels(nodeName string) (map[string]string, error) {
+	node := &corev1.Node{}
+	if err := r.client.Get(context.TODO(), client.ObjectKey{Name: nodeName}, node); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return node.GetLabels(), nil
+}
+
+func (r *ReconcileHostPathProvisioner) listNodeDaemonSets(namespace string) ([]appsv1.DaemonSet, error) {
+	dsList := &appsv1.DaemonSetList{}
+	if err := r.client.List(context.TODO(), dsList, client.InNamespace(namespace), client.HasLabels{nodeDaemonSetLabel}); err != nil {
+		return nil, err
+	}
+	return dsList.Items, nil
+}
+
+// checkCSIDaemonSetsReady returns true if all pods of the CSI DaemonSet and the node DaemonSets of the CR are ready,
+// and at least one of them runs. Unlike the CSI DaemonSet, a node DaemonSet without pods is ready, its node might not
+// be schedulable. The CSI DaemonSet has no pods if no node matches all storage pools.
+func (r *ReconcileHostPathProvisioner) checkCSIDaemonSetsReady(cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, daemonSetCsi *appsv1.DaemonSet) (bool, error) {
+	if daemonSetCsi.Status.NumberReady < daemonSetCsi.Status.DesiredNumberScheduled {
+		return false, nil
+	}
+	numberReady := daemonSetCsi.Status.NumberReady
+	daemonSets, err := r.listNodeDaemonSets(namespace)
+	if err != nil {
+		return false, err
+	}
+	for _, ds := range daemonSets {
+		if !metav1.IsControlledBy(&ds, cr) {
+			continue
+		}
+		if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+			return false, nil
+		}
+		numberReady += ds.Status.NumberReady
+	}
+	return numberReady > 0, nil
+}
+
+func getNodeDaemonSetName(nodeName string) string {
+	return getResourceNameWithMaxLength(fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), nodeName, maxNameLength)
+}
+
+// setNodeDaemonSet turns a CSI DaemonSet into the DaemonSet of the named nodes. The node DaemonSet gets its own name
+// and selector, so its pods are not shared with the main CSI DaemonSet.
+func setNodeDaemonSet(ds *appsv1.DaemonSet, name string, nodeNames []string) {
+	withNodeDaemonSetLabel := func(labels map[string]string) map[string]string {
+		res := make(map[string]string)
+		for k, v := range labels {
+			res[k] = v
+		}
+		res[nodeDaemonSetLabel] = name
+		return res
+	}
+	ds.SetName(name)
+	ds.SetLabels(withNodeDaemonSetLabel(ds.GetLabels()))
+	ds.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: withNodeDaemonSetLabel(selectorLabels),
+	}
+	ds.Spec.Template.SetLabels(withNodeDaemonSetLabel(ds.Spec.Template.GetLabels()))
+	ds.Spec.Template.Spec.Affinity = addRequiredNodeAffinity(ds.Spec.Template.Spec.Affinity, nil, []corev1.NodeSelectorRequirement{
+		{
+			Key:      metav1.ObjectNameField,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   nodeNames,
+		},
+	})
+}
+
+// addRequiredNodeAffinity returns a copy of the affinity with the node label expressions and node field requirements
+// added to all required node selector terms, so they apply on top of the affinity of the workload.
+func addRequiredNodeAffinity(affinity *corev1.Affinity, expressions, fields []corev1.NodeSelectorRequirement) *corev1.Affinity {
+	if len(expressions) == 0 && len(fields) == 0 {
+		return affinity
+	}
+	res := affinity.DeepCopy()
+	if res == nil {
+		res = &corev1.Affinity{}
+	}
+	if res.NodeAffinity == nil {
+		res.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	if res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	nodeSelector := res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		nodeSelector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range nodeSelector.NodeSelectorTerms {
+		nodeSelector.NodeSelectorTerms[i].MatchExpressions = append(nodeSelector.NodeSelectorTerms[i].MatchExpressions, expressions...)
+		nodeSelector.NodeSelectorTerms[i].MatchFields = append(nodeSelector.NodeSelectorTerms[i].MatchFields, fields...)
+	}
+	return res
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+)
+
+// storagePoolNodeState contains the objects that make up a storage pool on its nodes
+type storagePoolNodeState struct {
+	claims      map[string]corev1.PersistentVolumeClaimStatus
+	expansions  map[string]hostpathprovisionerv1.StoragePoolExpansionPhase
+	deployments map[string]appsv1.Deployment
+	podsByNode  map[string]corev1.Pod
+}
+
+// nodeStoragePoolStatuses contains the state of each storage pool on each of its nodes, by storage pool and node name
+type nodeStoragePoolStatuses map[string]map[string]hostpathprovisionerv1.NodeStoragePoolStatus
+
+// reconcileNodeStoragePools creates a NodeStoragePool for each node and storage pool, reporting the state of the pool
+// on the node. NodeStoragePools of removed nodes or storage pools are deleted. It returns the reported states.
+func (r *ReconcileHostPathProvisioner) reconcileNodeStoragePools(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, capacities storagePoolCapacities) (nodeStoragePoolStatuses, error) {
+	res := make(nodeStoragePoolStatuses)
+	nodes, err := r.getNodesByDaemonSet(logger, namespace)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].GetName() < nodes[j].GetName()
+	})
+	current, err := r.currentNodeStoragePools(cr)
+	if err != nil {
+		return nil, err
+	}
+	storagePools := cr.Spec.StoragePools
+	if cr.Spec.PathConfig != nil {
+		storagePools = []hostpathprovisionerv1.StoragePool{
+			{
+				Name: legacyStoragePoolName,
+				Path: cr.Spec.PathConfig.Path,
+			},
+		}
+	}
+	for _, storagePool := range storagePools {
+		state, err := r.getStoragePoolNodeState(cr, namespace, &storagePool)
+		if err != nil {
+			return nil, err
+		}
+		res[storagePool.Name] = make(map[string]hostpathprovisionerv1.NodeStoragePoolStatus)
+		for _, node := range filterNodesForStoragePool(&storagePool, nodes) {
+			desired := createNodeStoragePoolObject(&storagePool, node.GetName())
+			desired.Status = state.nodeStoragePoolStatus(&storagePool, node.GetName(), capacities[storagePool.Name][node.GetName()])
+			res[storagePool.Name][node.GetName()] = desired.Status
+			desired.SetOwnerReferences([]metav1.OwnerReference{
+				*metav1.NewControllerRef(cr, hostpathprovisionerv1.SchemeGroupVersion.WithKind("HostPathProvisioner")),
+			})
+			delete(current, desired.GetName())
+			if err := r.reconcileNodeStoragePool(logger, desired); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, nodeStoragePool := range current {
+		logger.V(3).Info("Deleting unused NodeStoragePool", "NodeStoragePool.Name", nodeStoragePool.GetName())
+		if err := r.client.Delete(context.TODO(), &nodeStoragePool); err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (r *ReconcileHostPathProvisioner) reconcileNodeStoragePool(logger logr.Logger, desired *hostpathprovisionerv1.NodeStoragePool) error {
+	found := &hostpathprovisionerv1.NodeStoragePool{}
+	err := r.client.Get(context.TODO(), client.ObjectKeyFromObject(desired), found)
+	if err != nil && errors.IsNotFound(err) {
+		logger.V(3).Info("Creating a new NodeStoragePool", "NodeStoragePool.Name", desired.GetName())
+		return r.client.Create(context.TODO(), desired)
+	} else if err != nil {
+		return err
+	}
+	currentRuntimeObjCopy := found.DeepCopy()
+
+	// The NodeStoragePools are read only, overwrite any changes except added labels and annotations
+	mergeLabelsAndAnnotations(desired, found)
+	found.SetOwnerReferences(desired.GetOwnerReferences())
+	found.Spec = desired.Spec
+	found.Status = desired.Status
+
+	if !reflect.DeepEqual(currentRuntimeObjCopy, found) {
+		logger.V(3).Info("Updating NodeStoragePool", "NodeStoragePool.Name", desired.GetName())
+		return r.client.Update(context.TODO(), found)
+	}
+	return nil
+}
+
+func (r *ReconcileHostPathProvisioner) currentNodeStoragePools(cr *hostpathprovisionerv1.HostPathProvisioner) (map[string]hostpathprovisionerv1.NodeStoragePool, error) {
+	res := make(map[string]hostpathprovisionerv1.NodeStoragePool)
+	nodeStoragePoolList := &hostpathprovisionerv1.NodeStoragePoolList{}
+	if err := r.client.List(context.TODO(), nodeStoragePoolList, client.MatchingLabels{
+		"k8s-app": MultiPurposeHostPathProvisionerName,
+	}); err != nil {
+		return res, err
+	}
+	for _, nodeStoragePool := range nodeStoragePoolList.Items {
+		if metav1.IsControlledBy(&nodeStoragePool, cr) {
+			res[nodeStoragePool.GetName()] = nodeStoragePool
+		}
+	}
+	return res, nil
+}
+
+func (r *ReconcileHostPathProvisioner) getStoragePoolNodeState(cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, storagePool *hostpathprovisionerv1.StoragePool) (*storagePoolNodeState, error) {
+	res := &storagePoolNodeState{
+		claims:      make(map[string]corev1.PersistentVolumeClaimStatus),
+		expansions:  make(map[string]hostpathprovisionerv1.StoragePoolExpansionPhase),
+		deployments: make(map[string]appsv1.Deployment),
+		podsByNode:  make(map[string]corev1.Pod),
+	}
+	if storagePool.PVCTemplate == nil {
+		return res, nil
+	}
+	claims, err := r.getClaimsByStoragePool(storagePool, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims {
+		res.claims[claim.GetName()] = claim.Status
+		if res.expansions[claim.GetName()], err = r.getClaimExpansionPhase(storagePool, &claim); err != nil {
+			return nil, err
+		}
+	}
+	deployments, err := r.storagePoolDeploymentsByStoragePool(cr, namespace, storagePool)
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments {
+		res.deployments[deployment.GetName()] = deployment
+	}
+	podList := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), podList, client.InNamespace(namespace), client.MatchingLabels{
+		hppPoolPrefix: getResourceNameWithMaxLength(storagePool.Name, "hpp", maxNameLength),
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(podList.Items, func(i, j int) bool {
+		return podList.Items[i].GetName() < podList.Items[j].GetName()
+	})
+	for _, pod := range podList.Items {
+		if _, ok := res.podsByNode[pod.Spec.NodeName]; !ok && pod.Spec.NodeName != "" && pod.DeletionTimestamp == nil {
+			res.podsByNode[pod.Spec.NodeName] = pod
+		}
+	}
+	return res, nil
+}
+
+func (s *storagePoolNodeState) nodeStoragePoolStatus(storagePool *hostpathprovisionerv1.StoragePool, nodeName string, capacity nodeCapacity) hostpathprovisionerv1.NodeStoragePoolStatus {
+	res := hostpathprovisionerv1.NodeStoragePoolStatus{
+		Capacity:    capacity.capacity,
+		Allocatable: capacity.allocatable,
+		Used:        capacity.used,
+	}
+	if storagePool.PVCTemplate == nil {
+		// The path on the host is used directly, there is nothing to mount.
+		res.Phase = hostpathprovisionerv1.StoragePoolReady
+		res.Mounted = true
+		return res
+	}
+	if isShared(storagePool.PVCTemplate) {
+		res.ClaimName = getSharedStoragePoolPVCName(storagePool.Name)
+	} else {
+		res.ClaimName = getStoragePoolPVCName(storagePool.Name, nodeName)
+	}
+	claimStatus, ok := s.claims[res.ClaimName]
+	if !ok {
+		res.Phase = hostpathprovisionerv1.StoragePoolPreparing
+		res.Reason = storagePoolReasonClaimNotFound
+		res.LastError = fmt.Sprintf("Pool PVC %s not found", res.ClaimName)
+		return res
+	}
+	res.ClaimPhase = claimStatus.Phase
+	if capacity, ok := claimStatus.Capacity[corev1.ResourceStorage]; ok {
+		res.ClaimCapacity = &capacity
+	}
+	res.Expansion = s.expansions[res.ClaimName]
+	switch claimStatus.Phase {
+	case corev1.ClaimBound:
+	case corev1.ClaimLost:
+		// The volume of the claim is gone, the pool cannot recover on the node without intervention.
+		res.Phase = hostpathprovisionerv1.StoragePoolFailed
+		res.Reason = storagePoolReasonClaimLost
+		res.LastError = fmt.Sprintf("Pool PVC %s lost its volume", res.ClaimName)
+		return res
+	default:
+		res.Phase = hostpathprovisionerv1.StoragePoolPreparing
+		res.Reason = storagePoolReasonClaimPending
+		res.LastError = fmt.Sprintf("Pool PVC %s is %s instead of %s", res.ClaimName, claimStatus.Phase, corev1.ClaimBound)
+		return res
+	}
+	pod, hasPod := s.podsByNode[nodeName]
+	if hasPod {
+		res.MounterPod = pod.GetName()
+	}
+	if deployment, ok := s.deployments[getStoragePoolDeploymentName(storagePool.Name, nodeName)]; ok && deployment.Status.ReadyReplicas == int32(1) {
+		res.Phase = hostpathprovisionerv1.StoragePoolReady
+		res.Mounted = true
+		// The mounter opens the encrypted device before mounting it.
+		res.Encrypted = storagePool.Encryption != nil
+		return res
+	}
+	res.Phase = hostpathprovisionerv1.StoragePoolMounting
+	res.Reason = storagePoolReasonMounterNotReady
+	if !hasPod {
+		res.LastError = "Mounter pod not found"
+		return res
+	}
+	res.LastError = mounterPodError(&pod)
+	if isMounterPodFailed(&pod) {
+		res.Phase = hostpathprovisionerv1.StoragePoolFailed
+		res.Reason = storagePoolReasonMounterFailed
+	}
+	return res
+}
+
+// mounterPodError returns the reason the mounter pod is not ready, or an empty string if there is none.
+func mounterPodError(pod *corev1.Pod) string {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if waiting := containerStatus.State.Waiting; waiting != nil && waiting.Reason != "" {
+			return fmt.Sprintf("container %s is waiting: %s %s", containerStatus.Name, waiting.Reason, waiting.Message)
+		}
+		if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return fmt.Sprintf("container %s terminated with exit code %d: %s", containerStatus.Name, terminated.ExitCode, terminated.Message)
+		}
+		if containerStatus.State.Running != nil && !containerStatus.Ready {
+			return fmt.Sprintf("container %s is running but the storage pool is not mounted on the node", containerStatus.Name)
+		}
+	}
+	return ""
+}
+
+func getNodeStoragePoolName(storagePoolName, nodeName string) string {
+	return getResourceNameWithMaxLength(storagePoolName, nodeName, validation.DNS1123SubdomainMaxLength)
+}
+
+func createNodeStoragePoolObject(storagePool *hostpathprovisionerv1.StoragePool, nodeName string) *hostpathprovisionerv1.NodeStoragePool {
+	labels := util.GetRecommendedLabels()
+	labels[storagePoolLabelKey] = getResourceNameWithMaxLength(storagePool.Name, "hpp", maxNameLength)
+	return &hostpathprovisionerv1.NodeStoragePool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hostpathprovisionerv1.SchemeGroupVersion.String(),
+			Kind:       "NodeStoragePool",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   getNodeStoragePoolName(storagePool.Name, nodeName),
+			Labels: withStoragePoolNodeLabel(labels, nodeName),
+		},
+		Spec: hostpathprovisionerv1.NodeStoragePoolSpec{
+			NodeName:        nodeName,
+			StoragePoolName: storagePool.Name,
+		},
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("node storage pools", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		getNodeStoragePool := func(cl client.Client, storagePoolName, nodeName string) *hppv1.NodeStoragePool {
+			nodeStoragePool := &hppv1.NodeStoragePool{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getNodeStoragePoolName(storagePoolName, nodeName)}, nodeStoragePool)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			return nodeStoragePool
+		}
+
+		listNodeStoragePools := func(cl client.Client) []hppv1.NodeStoragePool {
+			nodeStoragePoolList := &hppv1.NodeStoragePoolList{}
+			err := cl.List(context.TODO(), nodeStoragePoolList)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			return nodeStoragePoolList.Items
+		}
+
+		// addBasicStoragePoolNodes adds nodes running the CSI driver, basic storage pools have no claims to bind.
+		addBasicStoragePoolNodes := func(start, end int, r *ReconcileHostPathProvisioner, cl client.Client) {
+			addNodesToCluster(start, end, cl)
+			csiDs := &appsv1.DaemonSet{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			createCsiDsPods(start, end, csiDs, cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		}
+
+		ginkgo.It("Should create a node storage pool for each node of a basic storage pool", func() {
+			cr, r, cl := createDeployedCr(createLegacyStoragePoolCr())
+			addBasicStoragePoolNodes(1, 3, r, cl)
+			gomega.Expect(listNodeStoragePools(cl)).To(gomega.HaveLen(3))
+			nodeStoragePool := getNodeStoragePool(cl, "legacy", "node2")
+			gomega.Expect(nodeStoragePool.Spec).To(gomega.Equal(hppv1.NodeStoragePoolSpec{
+				NodeName:        "node2",
+				StoragePoolName: "legacy",
+			}))
+			gomega.Expect(nodeStoragePool.Status.Phase).To(gomega.Equal(hppv1.StoragePoolReady))
+			gomega.Expect(nodeStoragePool.Status.Mounted).To(gomega.BeTrue())
+			gomega.Expect(metav1.IsControlledBy(nodeStoragePool, cr)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should report the claim, mounter pod and capacity of a storage pool with a template", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			gomega.Expect(listNodeStoragePools(cl)).To(gomega.HaveLen(2))
+			nodeStoragePool := getNodeStoragePool(cl, "local", "node1")
+			gomega.Expect(nodeStoragePool.Status.Phase).To(gomega.Equal(hppv1.StoragePoolMounting))
+			gomega.Expect(nodeStoragePool.Status.Mounted).To(gomega.BeFalse())
+			gomega.Expect(nodeStoragePool.Status.ClaimName).To(gomega.Equal(getStoragePoolPVCName("local", "node1")))
+			gomega.Expect(nodeStoragePool.Status.ClaimPhase).To(gomega.Equal(corev1.ClaimBound))
+
+			ginkgo.By("Starting the mounter pod")
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			deployment.Status.ReadyReplicas = 1
+			gomega.Expect(cl.Status().Update(context.TODO(), deployment)).To(gomega.Succeed())
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "hpp-pool-local-node1-abcde",
+					Namespace: testNamespace,
+					Labels:    deployment.Spec.Template.GetLabels(),
+				},
+				Spec: corev1.PodSpec{
+					NodeName: "node1",
+				},
+			}
+			gomega.Expect(cl.Create(context.TODO(), pod)).To(gomega.Succeed())
+			gomega.Expect(cl.Create(context.TODO(), &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "local-csi",
+				},
+				Provisioner: driverName,
+				Parameters: map[string]string{
+					storagePoolParameter: "local",
+				},
+			})).To(gomega.Succeed())
+			gomega.Expect(cl.Create(context.TODO(), &storagev1.CSIStorageCapacity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "csisc-local-node1",
+					Namespace: testNamespace,
+					Labels: map[string]string{
+						csiStorageCapacityDriverLabel: driverName,
+					},
+				},
+				StorageClassName: "local-csi",
+				NodeTopology: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						topologyNodeKey: "node1",
+					},
+				},
+				Capacity:          ptr.To(resource.MustParse("30Gi")),
+				MaximumVolumeSize: ptr.To(resource.MustParse("50Gi")),
+			})).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			nodeStoragePool = getNodeStoragePool(cl, "local", "node1")
+			gomega.Expect(nodeStoragePool.Status.Phase).To(gomega.Equal(hppv1.StoragePoolReady))
+			gomega.Expect(nodeStoragePool.Status.Mounted).To(gomega.BeTrue())
+			gomega.Expect(nodeStoragePool.Status.MounterPod).To(gomega.Equal(pod.GetName()))
+			gomega.Expect(nodeStoragePool.Status.Capacity).To(gomega.Equal(ptr.To(resource.MustParse("50Gi"))))
+			gomega.Expect(nodeStoragePool.Status.Allocatable).To(gomega.Equal(ptr.To(resource.MustParse("30Gi"))))
+			gomega.Expect(nodeStoragePool.Status.Used).To(gomega.Equal(ptr.To(resource.MustParse("20Gi"))))
+			gomega.Expect(nodeStoragePool.Status.LastError).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should report why the mounter pod is not ready", func() {
+			pod := &corev1.Pod{
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{
+							Name: "mounter",
+							State: corev1.ContainerState{
+								Waiting: &corev1.ContainerStateWaiting{
+									Reason:  "CrashLoopBackOff",
+									Message: "back-off restarting failed container",
+								},
+							},
+						},
+					},
+				},
+			}
+			gomega.Expect(mounterPodError(pod)).To(gomega.Equal("container mounter is waiting: CrashLoopBackOff back-off restarting failed container"))
+		})
+
+		ginkgo.It("Should report a running mounter pod that failed its readiness probe", func() {
+			pod := &corev1.Pod{
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{
+							Name:  "mounter",
+							Ready: false,
+							State: corev1.ContainerState{
+								Running: &corev1.ContainerStateRunning{},
+							},
+						},
+					},
+				},
+			}
+			gomega.Expect(mounterPodError(pod)).To(gomega.Equal("container mounter is running but the storage pool is not mounted on the node"))
+			pod.Status.ContainerStatuses[0].Ready = true
+			gomega.Expect(mounterPodError(pod)).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should restore a modified node storage pool", func() {
+			_, r, cl := createDeployedCr(createLegacyStoragePoolCr())
+			addBasicStoragePoolNodes(1, 1, r, cl)
+			nodeStoragePool := getNodeStoragePool(cl, "legacy", "node1")
+			nodeStoragePool.Spec.NodeName = "other"
+			nodeStoragePool.Status.Phase = hppv1.StoragePoolPreparing
+			gomega.Expect(cl.Update(context.TODO(), nodeStoragePool)).To(gomega.Succeed())
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			nodeStoragePool = getNodeStoragePool(cl, "legacy", "node1")
+			gomega.Expect(nodeStoragePool.Spec.NodeName).To(gomega.Equal("node1"))
+			gomega.Expect(nodeStoragePool.Status.Phase).To(gomega.Equal(hppv1.StoragePoolReady))
+		})
+
+		ginkgo.It("Should remove the node storage pools of removed nodes", func() {
+			cr, r, cl := createDeployedCr(createLegacyStoragePoolCr())
+			addBasicStoragePoolNodes(1, 4, r, cl)
+			gomega.Expect(listNodeStoragePools(cl)).To(gomega.HaveLen(4))
+			scaleClusterNodesAndDsDown(3, 4, 2, cr, r, cl)
+			gomega.Expect(listNodeStoragePools(cl)).To(gomega.HaveLen(2))
+		})
+	})
+})
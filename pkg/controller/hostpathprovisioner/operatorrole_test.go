@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+// apiRequest is a request the operator sends to the api server
+type apiRequest struct {
+	verb      string
+	group     string
+	resource  string
+	namespace string
+	name      string
+}
+
+func (a apiRequest) String() string {
+	return fmt.Sprintf("%s %s.%s %s/%s", a.verb, a.resource, a.group, a.namespace, a.name)
+}
+
+// allowedBy returns true if one of the rules allows the request. Rules with resource names never allow list and
+// create requests, like in the api server.
+func (a apiRequest) allowedBy(rules []rbacv1.PolicyRule) bool {
+	matches := func(values []string, value string) bool {
+		return slices.Contains(values, rbacv1.VerbAll) || slices.Contains(values, value)
+	}
+	for _, rule := range rules {
+		if !matches(rule.APIGroups, a.group) || !matches(rule.Resources, a.resource) || !matches(rule.Verbs, a.verb) {
+			continue
+		}
+		if len(rule.ResourceNames) == 0 || (a.name != "" && slices.Contains(rule.ResourceNames, a.name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordRequests replaces the clients of the reconciler with clients that record the requests they send
+func recordRequests(r *ReconcileHostPathProvisioner, cl client.Client) *[]apiRequest {
+	requests := make([]apiRequest, 0)
+	record := func(verb string, obj runtime.Object, subResource, namespace, name string) {
+		gvk, err := apiutil.GVKForObject(obj, r.scheme)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+		resource, _ := meta.UnsafeGuessKindToResource(gvk)
+		if strings.HasSuffix(gvk.Kind, "Constraints") {
+			// The kind of the security context constraints is already plural
+			resource.Resource = strings.ToLower(gvk.Kind)
+		}
+		if subResource != "" {
+			resource.Resource += "/" + subResource
+		}
+		requests = append(requests, apiRequest{verb: verb, group: gvk.Group, resource: resource.Resource, namespace: namespace, name: name})
+	}
+	recording := interceptor.NewClient(cl.(erroringFakeCtrlRuntimeClient).Client.(client.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			record("get", obj, "", key.Namespace, key.Name)
+			return c.Get(ctx, key, obj, opts...)
+		},
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+			record("list", list, "", listOpts.Namespace, "")
+			return c.List(ctx, list, opts...)
+		},
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			record("create", obj, "", obj.GetNamespace(), "")
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			record("update", obj, "", obj.GetNamespace(), obj.GetName())
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			record("patch", obj, "", obj.GetNamespace(), obj.GetName())
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			record("delete", obj, "", obj.GetNamespace(), obj.GetName())
+			return c.Delete(ctx, obj, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			record("update", obj, subResourceName, obj.GetNamespace(), obj.GetName())
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			record("patch", obj, subResourceName, obj.GetNamespace(), obj.GetName())
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	})
+	r.client = recording
+	r.apiReader = recording
+	return &requests
+}
+
+// expectAllowedByOperatorRole checks the requests are allowed by the cluster role the operator is deployed with, or by
+// its role in the namespace of the operator.
+func expectAllowedByOperatorRole(requests []apiRequest) {
+	content, err := os.ReadFile("../../../deploy/operator.yaml")
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	clusterRole := &rbacv1.ClusterRole{}
+	role := &rbacv1.Role{}
+	for _, document := range strings.Split(string(content), "\n---\n") {
+		typeMeta := &metav1.TypeMeta{}
+		gomega.Expect(yaml.Unmarshal([]byte(document), typeMeta)).To(gomega.Succeed())
+		switch typeMeta.Kind {
+		case "ClusterRole":
+			gomega.Expect(yaml.Unmarshal([]byte(document), clusterRole)).To(gomega.Succeed())
+		case "Role":
+			gomega.Expect(yaml.Unmarshal([]byte(document), role)).To(gomega.Succeed())
+		}
+	}
+	gomega.Expect(clusterRole.Rules).ToNot(gomega.BeEmpty())
+	gomega.Expect(role.Rules).ToNot(gomega.BeEmpty())
+	gomega.Expect(requests).ToNot(gomega.BeEmpty())
+	denied := make([]string, 0)
+	for _, request := range requests {
+		if request.allowedBy(clusterRole.Rules) {
+			continue
+		}
+		// The cache only covers the namespace of the operator, listing namespaced objects in all namespaces only lists
+		// the ones in the namespace of the operator.
+		if (request.namespace == testNamespace || (request.verb == "list" && request.namespace == "")) && request.allowedBy(role.Rules) {
+			continue
+		}
+		denied = append(denied, request.String())
+	}
+	gomega.Expect(denied).To(gomega.BeEmpty())
+}
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("operator role", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		ginkgo.It("Should allow managing the node DaemonSets", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			requests := recordRequests(r, cl)
+			setLogVerbosity := func(logVerbosity *hppv1.LogVerbosity) {
+				gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+				cr.Spec.LogVerbosity = logVerbosity
+				gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+				_, err := r.Reconcile(context.TODO(), req)
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
+			setLogVerbosity(&hppv1.LogVerbosity{Nodes: []hppv1.NodeLogVerbosity{{NodeName: "node1", Default: ptr.To[int32](5)}}})
+			setLogVerbosity(&hppv1.LogVerbosity{Nodes: []hppv1.NodeLogVerbosity{{NodeName: "node1", Default: ptr.To[int32](7)}}})
+			setLogVerbosity(nil)
+			verbs := make([]string, 0)
+			for _, request := range *requests {
+				if request.resource == "daemonsets" && strings.HasPrefix(request.name, getNodeDaemonSetName("node1")) {
+					verbs = append(verbs, request.verb)
+				}
+			}
+			gomega.Expect(verbs).To(gomega.ContainElements("update", "delete"))
+			expectAllowedByOperatorRole(*requests)
+		})
+	})
+})
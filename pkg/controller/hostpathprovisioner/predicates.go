@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"reflect"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+)
+
+// nodePredicate passes the node events that change the storage pools of a node: nodes joining and leaving the cluster,
+// and changes to the labels the storage pool node selectors match or to the skip cleanup annotation.
+func nodePredicate() predicate.TypedPredicate[*corev1.Node] {
+	return predicate.TypedFuncs[*corev1.Node]{
+		UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Node]) bool {
+			return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) ||
+				e.ObjectOld.GetAnnotations()[skipCleanupAnnotation] != e.ObjectNew.GetAnnotations()[skipCleanupAnnotation]
+		},
+		GenericFunc: func(_ event.TypedGenericEvent[*corev1.Node]) bool {
+			return false
+		},
+	}
+}
+
+// hasStoragePoolLabel returns true if the object belongs to a storage pool
+func hasStoragePoolLabel(o client.Object) bool {
+	_, ok := o.GetLabels()[storagePoolLabelKey]
+	return ok
+}
+
+// storagePoolPVCPredicate passes the events of storage pool claims that are created, deleted, or change their status,
+// binding or resizing.
+func storagePoolPVCPredicate() predicate.TypedPredicate[*corev1.PersistentVolumeClaim] {
+	return predicate.And[*corev1.PersistentVolumeClaim](
+		predicate.NewTypedPredicateFuncs(func(o *corev1.PersistentVolumeClaim) bool {
+			return hasStoragePoolLabel(o)
+		}),
+		predicate.TypedFuncs[*corev1.PersistentVolumeClaim]{
+			UpdateFunc: func(e event.TypedUpdateEvent[*corev1.PersistentVolumeClaim]) bool {
+				return !equality.Semantic.DeepEqual(e.ObjectOld.Status, e.ObjectNew.Status)
+			},
+		},
+	)
+}
+
+// storagePoolJobPredicate passes the events of storage pool cleanup Jobs that are created, deleted, or change their
+// status, finishing or failing.
+func storagePoolJobPredicate() predicate.TypedPredicate[*batchv1.Job] {
+	return predicate.And[*batchv1.Job](
+		predicate.NewTypedPredicateFuncs(func(o *batchv1.Job) bool {
+			return hasStoragePoolLabel(o)
+		}),
+		predicate.TypedFuncs[*batchv1.Job]{
+			UpdateFunc: func(e event.TypedUpdateEvent[*batchv1.Job]) bool {
+				return !equality.Semantic.DeepEqual(e.ObjectOld.Status, e.ObjectNew.Status)
+			},
+		},
+	)
+}
+
+// persistentVolumePredicate passes the deletion of persistent volumes provisioned by the hostpath provisioner, which
+// can resolve stranded volumes.
+func persistentVolumePredicate() predicate.TypedPredicate[*corev1.PersistentVolume] {
+	return predicate.TypedFuncs[*corev1.PersistentVolume]{
+		CreateFunc: func(_ event.TypedCreateEvent[*corev1.PersistentVolume]) bool {
+			return false
+		},
+		UpdateFunc: func(_ event.TypedUpdateEvent[*corev1.PersistentVolume]) bool {
+			return false
+		},
+		DeleteFunc: func(e event.TypedDeleteEvent[*corev1.PersistentVolume]) bool {
+			return util.IsHostPathProvisionerVolume(e.Object)
+		},
+		GenericFunc: func(_ event.TypedGenericEvent[*corev1.PersistentVolume]) bool {
+			return false
+		},
+	}
+}
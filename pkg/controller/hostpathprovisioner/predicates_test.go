@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+)
+
+var _ = ginkgo.Describe("Watch predicates", func() {
+	ginkgo.It("Should pass nodes joining, leaving and changing labels", func() {
+		p := nodePredicate()
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node1",
+				Labels: map[string]string{"disk": "nvme"},
+			},
+		}
+		gomega.Expect(p.Create(event.TypedCreateEvent[*corev1.Node]{Object: node})).To(gomega.BeTrue())
+		gomega.Expect(p.Delete(event.TypedDeleteEvent[*corev1.Node]{Object: node})).To(gomega.BeTrue())
+
+		heartbeat := node.DeepCopy()
+		heartbeat.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.Node]{ObjectOld: node, ObjectNew: heartbeat})).To(gomega.BeFalse())
+
+		relabeled := node.DeepCopy()
+		relabeled.Labels = nil
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.Node]{ObjectOld: node, ObjectNew: relabeled})).To(gomega.BeTrue())
+
+		skipped := node.DeepCopy()
+		skipped.Annotations = map[string]string{skipCleanupAnnotation: "true"}
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.Node]{ObjectOld: node, ObjectNew: skipped})).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("Should only pass status changes of storage pool claims", func() {
+		p := storagePoolPVCPredicate()
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "hpp-pool-local-node1",
+				Labels: map[string]string{storagePoolLabelKey: "local-hpp"},
+			},
+		}
+		gomega.Expect(p.Create(event.TypedCreateEvent[*corev1.PersistentVolumeClaim]{Object: pvc})).To(gomega.BeTrue())
+		gomega.Expect(p.Delete(event.TypedDeleteEvent[*corev1.PersistentVolumeClaim]{Object: pvc})).To(gomega.BeTrue())
+
+		annotated := pvc.DeepCopy()
+		annotated.Annotations = map[string]string{"foo": "bar"}
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.PersistentVolumeClaim]{ObjectOld: pvc, ObjectNew: annotated})).To(gomega.BeFalse())
+
+		bound := pvc.DeepCopy()
+		bound.Status.Phase = corev1.ClaimBound
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.PersistentVolumeClaim]{ObjectOld: pvc, ObjectNew: bound})).To(gomega.BeTrue())
+
+		other := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "user-claim",
+			},
+		}
+		gomega.Expect(p.Create(event.TypedCreateEvent[*corev1.PersistentVolumeClaim]{Object: other})).To(gomega.BeFalse())
+		otherBound := other.DeepCopy()
+		otherBound.Status.Phase = corev1.ClaimBound
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.PersistentVolumeClaim]{ObjectOld: other, ObjectNew: otherBound})).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("Should only pass status changes of storage pool jobs", func() {
+		p := storagePoolJobPredicate()
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "cleanup-pool-local-node1",
+				Labels: map[string]string{storagePoolLabelKey: "local-hpp"},
+			},
+		}
+		gomega.Expect(p.Create(event.TypedCreateEvent[*batchv1.Job]{Object: job})).To(gomega.BeTrue())
+
+		succeeded := job.DeepCopy()
+		succeeded.Status.Succeeded = 1
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*batchv1.Job]{ObjectOld: job, ObjectNew: succeeded})).To(gomega.BeTrue())
+
+		relabeled := job.DeepCopy()
+		relabeled.Labels["foo"] = "bar"
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*batchv1.Job]{ObjectOld: job, ObjectNew: relabeled})).To(gomega.BeFalse())
+
+		other := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "user-job",
+			},
+		}
+		gomega.Expect(p.Delete(event.TypedDeleteEvent[*batchv1.Job]{Object: other})).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("Should only pass the deletion of hostpath provisioner volumes", func() {
+		p := persistentVolumePredicate()
+		pv := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pvc-1234",
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{Driver: util.CSIDriverName},
+				},
+			},
+		}
+		gomega.Expect(p.Create(event.TypedCreateEvent[*corev1.PersistentVolume]{Object: pv})).To(gomega.BeFalse())
+		gomega.Expect(p.Update(event.TypedUpdateEvent[*corev1.PersistentVolume]{ObjectOld: pv, ObjectNew: pv})).To(gomega.BeFalse())
+		gomega.Expect(p.Delete(event.TypedDeleteEvent[*corev1.PersistentVolume]{Object: pv})).To(gomega.BeTrue())
+
+		other := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nfs",
+			},
+		}
+		gomega.Expect(p.Delete(event.TypedDeleteEvent[*corev1.PersistentVolume]{Object: other})).To(gomega.BeFalse())
+	})
+})
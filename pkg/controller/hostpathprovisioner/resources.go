@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+// defaultDaemonSetRequests are the requests of the DaemonSet containers if none are configured in the CR
+func defaultDaemonSetRequests() corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("150Mi"),
+	}
+}
+
+// defaultStoragePoolRequests are the requests of the mounter and cleanup containers if none are configured in the CR
+func defaultStoragePoolRequests() corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("100Mi"),
+	}
+}
+
+// getComponentResources returns the component resources of the CR, all components are unset if the CR has none.
+func getComponentResources(cr *hostpathprovisionerv1.HostPathProvisioner) hostpathprovisionerv1.ComponentResources {
+	if cr.Spec.Resources == nil {
+		return hostpathprovisionerv1.ComponentResources{}
+	}
+	return *cr.Spec.Resources
+}
+
+// containerResources returns the resource requirements of a container. The configured requirements are used as is if
+// they contain requests, otherwise the default requests are added. A default request larger than the configured limit
+// is lowered to the limit, so it doesn't make the container invalid.
+func containerResources(configured *corev1.ResourceRequirements, defaultRequests corev1.ResourceList) corev1.ResourceRequirements {
+	if configured == nil {
+		return corev1.ResourceRequirements{
+			Requests: defaultRequests,
+		}
+	}
+	res := *configured.DeepCopy()
+	if len(res.Requests) > 0 || len(defaultRequests) == 0 {
+		return res
+	}
+	res.Requests = defaultRequests
+	for name, request := range res.Requests {
+		if limit, ok := res.Limits[name]; ok && request.Cmp(limit) > 0 {
+			res.Requests[name] = limit.DeepCopy()
+		}
+	}
+	return res
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+)
+
+const (
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	storagePoolParameter          = "storagePool"
+	managedStorageClassLabelKey   = "hostpathprovisioner.kubevirt.io/managed-storage-class"
+
+	storageClassConflict = "StorageClassConflict"
+	// storageClassConflictMessage is reported in the status of a storage class that exists but is not managed by the operator
+	storageClassConflictMessage = "StorageClass already exists and is not managed by the hostpath provisioner"
+)
+
+func (r *ReconcileHostPathProvisioner) reconcileStorageClasses(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner) (reconcile.Result, error) {
+	current, err := r.currentStorageClasses()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	for _, storageClass := range cr.Spec.StorageClasses {
+		desired := createStorageClassObject(&storageClass)
+		delete(current, desired.GetName())
+		if err := r.reconcileStorageClass(logger, cr, desired); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	// Remove the storage classes that are no longer in the CR.
+	for _, storageClass := range current {
+		logger.Info("Deleting unused StorageClass", "StorageClass.Name", storageClass.GetName())
+		if err := r.client.Delete(context.TODO(), &storageClass); err != nil && !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileHostPathProvisioner) reconcileStorageClass(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, desired *storagev1.StorageClass) error {
+	found := &storagev1.StorageClass{}
+	err := r.client.Get(context.TODO(), client.ObjectKeyFromObject(desired), found)
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("Creating a new StorageClass", "StorageClass.Name", desired.GetName())
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			r.recorder.Event(cr, corev1.EventTypeWarning, createResourceFailed, fmt.Sprintf(createMessageFailed, desired.GetName(), err))
+			return err
+		}
+		r.recorder.Event(cr, corev1.EventTypeNormal, createResourceSuccess, fmt.Sprintf(createMessageSucceeded, desired, desired.GetName()))
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !isManagedStorageClass(found) {
+		// Leave the storage class of the user alone, the conflict is reported in the status of the storage class. Only
+		// emit the event when the conflict is new, so it is not repeated on every reconcile.
+		logger.Info("StorageClass is not managed by the hostpath provisioner, not updating it", "StorageClass.Name", found.GetName())
+		if getStorageClassStatusMessage(cr, found.GetName()) != storageClassConflictMessage {
+			r.recorder.Event(cr, corev1.EventTypeWarning, storageClassConflict, fmt.Sprintf("StorageClass %s already exists and is not managed by the hostpath provisioner", found.GetName()))
+		}
+		return nil
+	}
+
+	if !storageClassImmutableFieldsEqual(desired, found) {
+		// Most of the storage class fields are immutable, the storage class has to be recreated. Existing volumes are
+		// not affected by deleting the storage class.
+		logger.Info("Recreating StorageClass", "StorageClass.Name", desired.GetName())
+		if err := r.client.Delete(context.TODO(), found); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			r.recorder.Event(cr, corev1.EventTypeWarning, createResourceFailed, fmt.Sprintf(createMessageFailed, desired.GetName(), err))
+			return err
+		}
+		r.recorder.Event(cr, corev1.EventTypeNormal, updateResourceSuccess, fmt.Sprintf(updateMessageSucceeded, desired, desired.GetName()))
+		return nil
+	}
+
+	// Keep a copy of the original for comparison later.
+	currentRuntimeObjCopy := found.DeepCopyObject()
+
+	// allow users to add new annotations (but not change ours)
+	mergeLabelsAndAnnotations(desired, found)
+	found.AllowVolumeExpansion = desired.AllowVolumeExpansion
+
+	if !reflect.DeepEqual(currentRuntimeObjCopy, found) {
+		logJSONDiff(logger, currentRuntimeObjCopy, found)
+		logger.Info("Updating StorageClass", "StorageClass.Name", desired.GetName())
+		if err := r.client.Update(context.TODO(), found); err != nil {
+			r.recorder.Event(cr, corev1.EventTypeWarning, updateResourceFailed, fmt.Sprintf(updateMessageFailed, desired.GetName(), err))
+			return err
+		}
+		r.recorder.Event(cr, corev1.EventTypeNormal, updateResourceSuccess, fmt.Sprintf(updateMessageSucceeded, desired, desired.GetName()))
+	}
+	return nil
+}
+
+func storageClassImmutableFieldsEqual(desired, current *storagev1.StorageClass) bool {
+	return desired.Provisioner == current.Provisioner &&
+		reflect.DeepEqual(desired.Parameters, current.Parameters) &&
+		reflect.DeepEqual(desired.ReclaimPolicy, current.ReclaimPolicy) &&
+		reflect.DeepEqual(desired.VolumeBindingMode, current.VolumeBindingMode)
+}
+
+func isManagedStorageClass(storageClass *storagev1.StorageClass) bool {
+	return storageClass.GetLabels()[managedStorageClassLabelKey] == "true"
+}
+
+// currentStorageClasses returns the storage classes declared in the CR, this excludes the overlay backend storage classes.
+func (r *ReconcileHostPathProvisioner) currentStorageClasses() (map[string]storagev1.StorageClass, error) {
+	res := make(map[string]storagev1.StorageClass)
+	storageClassList := &storagev1.StorageClassList{}
+	if err := r.client.List(context.TODO(), storageClassList, client.MatchingLabels{
+		"k8s-app":                   MultiPurposeHostPathProvisionerName,
+		managedStorageClassLabelKey: "true",
+	}); err != nil {
+		return res, err
+	}
+	for _, storageClass := range storageClassList.Items {
+		res[storageClass.GetName()] = storageClass
+	}
+	return res, nil
+}
+
+func (r *ReconcileHostPathProvisioner) deleteStorageClasses() error {
+	current, err := r.currentStorageClasses()
+	if err != nil {
+		return err
+	}
+	for _, storageClass := range current {
+		if err := r.client.Delete(context.TODO(), &storageClass); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func createStorageClassObject(storageClass *hostpathprovisionerv1.StorageClass) *storagev1.StorageClass {
+	reclaimPolicy := corev1.PersistentVolumeReclaimDelete
+	if storageClass.ReclaimPolicy != nil {
+		reclaimPolicy = *storageClass.ReclaimPolicy
+	}
+	bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+	if storageClass.VolumeBindingMode != nil {
+		bindingMode = *storageClass.VolumeBindingMode
+	}
+	labels := util.GetRecommendedLabels()
+	labels[managedStorageClassLabelKey] = "true"
+	return &storagev1.StorageClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "storage.k8s.io/v1",
+			Kind:       "StorageClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   storageClass.Name,
+			Labels: labels,
+			Annotations: map[string]string{
+				defaultStorageClassAnnotation: strconv.FormatBool(storageClass.Default),
+			},
+		},
+		Provisioner: driverName,
+		Parameters: map[string]string{
+			storagePoolParameter: storageClass.StoragePool,
+		},
+		ReclaimPolicy:        &reclaimPolicy,
+		VolumeBindingMode:    &bindingMode,
+		AllowVolumeExpansion: storageClass.AllowVolumeExpansion,
+	}
+}
+
+// reconcileStorageClassStatus updates the storage class statuses, it expects the storage pool statuses to be up to date.
+func (r *ReconcileHostPathProvisioner) reconcileStorageClassStatus(cr *hostpathprovisionerv1.HostPathProvisioner) error {
+	poolPhases := make(map[string]hostpathprovisionerv1.StoragePoolPhase)
+	for _, poolStatus := range cr.Status.StoragePoolStatuses {
+		poolPhases[poolStatus.Name] = poolStatus.Phase
+	}
+	newStorageClassStatuses := make([]hostpathprovisionerv1.StorageClassStatus, 0)
+	for _, storageClass := range cr.Spec.StorageClasses {
+		status := hostpathprovisionerv1.StorageClassStatus{
+			Name:        storageClass.Name,
+			StoragePool: storageClass.StoragePool,
+		}
+		found := &storagev1.StorageClass{}
+		if err := r.client.Get(context.TODO(), client.ObjectKey{Name: storageClass.Name}, found); err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			status.Message = "StorageClass does not exist"
+		} else if !isManagedStorageClass(found) {
+			status.Message = storageClassConflictMessage
+		} else if !storageClassImmutableFieldsEqual(createStorageClassObject(&storageClass), found) {
+			status.Message = "StorageClass does not match the desired configuration"
+		} else if phase, ok := poolPhases[storageClass.StoragePool]; !ok {
+			status.Message = fmt.Sprintf("Storage pool %s does not exist", storageClass.StoragePool)
+		} else if phase != hostpathprovisionerv1.StoragePoolReady {
+			status.Message = fmt.Sprintf("Storage pool %s is %s", storageClass.StoragePool, phase)
+		} else {
+			status.Ready = true
+		}
+		newStorageClassStatuses = append(newStorageClassStatuses, status)
+	}
+	sort.Slice(newStorageClassStatuses, func(i, j int) bool {
+		return strings.Compare(newStorageClassStatuses[i].Name, newStorageClassStatuses[j].Name) == -1
+	})
+	cr.Status.StorageClassStatuses = newStorageClassStatuses
+	return nil
+}
+
+// getStorageClassStatusMessage returns the message in the current status of the named storage class
+func getStorageClassStatusMessage(cr *hostpathprovisionerv1.HostPathProvisioner, name string) string {
+	for _, status := range cr.Status.StorageClassStatuses {
+		if status.Name == name {
+			return status.Message
+		}
+	}
+	return ""
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("storage classes", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		drainEvents := func(recorder *record.FakeRecorder) []string {
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			return events
+		}
+
+		createStorageClassCr := func() *hppv1.HostPathProvisioner {
+			cr := createLegacyStoragePoolCr()
+			cr.Spec.StorageClasses = []hppv1.StorageClass{
+				{
+					Name:        "hostpath-csi",
+					StoragePool: "legacy",
+					Default:     true,
+				},
+				{
+					Name:                 "hostpath-csi-immediate",
+					StoragePool:          "legacy",
+					ReclaimPolicy:        ptr.To(corev1.PersistentVolumeReclaimRetain),
+					VolumeBindingMode:    ptr.To(storagev1.VolumeBindingImmediate),
+					AllowVolumeExpansion: ptr.To(true),
+				},
+			}
+			return cr
+		}
+
+		getStorageClass := func(cl client.Client, name string) (*storagev1.StorageClass, error) {
+			sc := &storagev1.StorageClass{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: name}, sc)
+			return sc, err
+		}
+
+		ginkgo.It("Should create the storage classes in the CR", func() {
+			cr, _, cl := createDeployedCr(createStorageClassCr())
+			sc, err := getStorageClass(cl, "hostpath-csi")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(sc.Provisioner).To(gomega.Equal(driverName))
+			gomega.Expect(sc.Parameters).To(gomega.Equal(map[string]string{"storagePool": "legacy"}))
+			gomega.Expect(*sc.ReclaimPolicy).To(gomega.Equal(corev1.PersistentVolumeReclaimDelete))
+			gomega.Expect(*sc.VolumeBindingMode).To(gomega.Equal(storagev1.VolumeBindingWaitForFirstConsumer))
+			gomega.Expect(sc.AllowVolumeExpansion).To(gomega.BeNil())
+			gomega.Expect(sc.Annotations[defaultStorageClassAnnotation]).To(gomega.Equal("true"))
+			gomega.Expect(sc.Labels[AppKubernetesPartOfLabel]).To(gomega.Equal("testing"))
+			gomega.Expect(sc.Labels[managedStorageClassLabelKey]).To(gomega.Equal("true"))
+
+			sc, err = getStorageClass(cl, "hostpath-csi-immediate")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(*sc.ReclaimPolicy).To(gomega.Equal(corev1.PersistentVolumeReclaimRetain))
+			gomega.Expect(*sc.VolumeBindingMode).To(gomega.Equal(storagev1.VolumeBindingImmediate))
+			gomega.Expect(*sc.AllowVolumeExpansion).To(gomega.BeTrue())
+			gomega.Expect(sc.Annotations[defaultStorageClassAnnotation]).To(gomega.Equal("false"))
+
+			err = cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cr.Status.StorageClassStatuses).To(gomega.Equal([]hppv1.StorageClassStatus{
+				{Name: "hostpath-csi", StoragePool: "legacy", Ready: true},
+				{Name: "hostpath-csi-immediate", StoragePool: "legacy", Ready: true},
+			}))
+		})
+
+		ginkgo.It("Should update, recreate and delete storage classes when the CR changes", func() {
+			cr, r, cl := createDeployedCr(createStorageClassCr())
+			err := cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			cr.Spec.StorageClasses[0].Default = false
+			cr.Spec.StorageClasses[0].ReclaimPolicy = ptr.To(corev1.PersistentVolumeReclaimRetain)
+			cr.Spec.StorageClasses = cr.Spec.StorageClasses[:1]
+			err = cl.Update(context.TODO(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			sc, err := getStorageClass(cl, "hostpath-csi")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(*sc.ReclaimPolicy).To(gomega.Equal(corev1.PersistentVolumeReclaimRetain))
+			gomega.Expect(sc.Annotations[defaultStorageClassAnnotation]).To(gomega.Equal("false"))
+			_, err = getStorageClass(cl, "hostpath-csi-immediate")
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+
+			err = cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cr.Status.StorageClassStatuses).To(gomega.HaveLen(1))
+		})
+
+		ginkgo.It("Should restore a modified storage class", func() {
+			_, r, cl := createDeployedCr(createStorageClassCr())
+			sc, err := getStorageClass(cl, "hostpath-csi-immediate")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			sc.AllowVolumeExpansion = ptr.To(false)
+			sc.Annotations["user"] = "annotation"
+			err = cl.Update(context.TODO(), sc)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			sc, err = getStorageClass(cl, "hostpath-csi-immediate")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(*sc.AllowVolumeExpansion).To(gomega.BeTrue())
+			gomega.Expect(sc.Annotations["user"]).To(gomega.Equal("annotation"))
+		})
+
+		ginkgo.It("Should not take over a storage class that is not managed by the operator", func() {
+			cr := createStorageClassCr()
+			_, r, cl := createDeployedCr(createLegacyStoragePoolCr())
+			err := cl.Create(context.TODO(), &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "hostpath-csi",
+				},
+				Provisioner: driverName,
+			})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			current := &hppv1.HostPathProvisioner{}
+			err = cl.Get(context.TODO(), req.NamespacedName, current)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			current.Spec.StorageClasses = cr.Spec.StorageClasses
+			err = cl.Update(context.TODO(), current)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(storageClassConflict)))
+			sc, err := getStorageClass(cl, "hostpath-csi")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(sc.GetLabels()).ToNot(gomega.HaveKey(managedStorageClassLabelKey))
+			_, err = getStorageClass(cl, "hostpath-csi-immediate")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			err = cl.Get(context.TODO(), req.NamespacedName, current)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(conditions.IsStatusConditionTrue(current.Status.Conditions, conditions.ConditionAvailable)).To(gomega.BeTrue())
+			gomega.Expect(conditions.IsStatusConditionTrue(current.Status.Conditions, conditions.ConditionDegraded)).To(gomega.BeFalse())
+			gomega.Expect(current.Status.StorageClassStatuses).To(gomega.ContainElement(hppv1.StorageClassStatus{
+				Name:        "hostpath-csi",
+				StoragePool: current.Spec.StorageClasses[0].StoragePool,
+				Message:     storageClassConflictMessage,
+			}))
+
+			ginkgo.By("Reconciling again, it should not repeat the event")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(storageClassConflict)))
+		})
+
+		ginkgo.It("Should delete the storage classes when the CR is deleted", func() {
+			cr, r, cl := createDeployedCr(createStorageClassCr())
+			err := cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			err = cl.Delete(context.TODO(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			scList := &storagev1.StorageClassList{}
+			err = cl.List(context.TODO(), scList)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(scList.Items).To(gomega.BeEmpty())
+		})
+	})
+})
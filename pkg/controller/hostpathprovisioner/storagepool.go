@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	secv1 "github.com/openshift/api/security/v1"
@@ -32,9 +33,10 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -46,13 +48,20 @@ import (
 
 const (
 	storagePoolLabelKey     = "kubevirt.io.hostpath-provisioner/storagePool"
+	storagePoolNodeLabelKey = "kubevirt.io.hostpath-provisioner/node"
 	dataName                = "data"
 	fsDataMountPath         = "/source"
 	blockDataMountPath      = "/dev/data"
 	defaultStorageClassName = "default"
 	hppPoolPrefix           = "hpp-pool"
 	maxNameLength           = 63
-	defaultOverlaySCName    = "hpp-overlay"
+	defaultOverlaySCName    = hostpathprovisionerv1.DefaultOverlayClassName
+	// mounterHTTPPort is the default port the mounter serves its metrics, health and readiness endpoints on
+	mounterHTTPPort = 8080
+	// defaultUnmountTimeout is how long the mounter retries to unmount a busy storage pool
+	defaultUnmountTimeout = 5 * time.Minute
+	// unmountGracePeriod is the time the mounter pod gets to exit on top of the unmount timeout
+	unmountGracePeriod = 30 * time.Second
 )
 
 // StoragePoolInfo contains the name and path of a hostpath storage pool.
@@ -77,15 +86,17 @@ func (r *ReconcileHostPathProvisioner) reconcileStoragePools(logger logr.Logger,
 	for _, storagePool := range cr.Spec.StoragePools {
 		logger.V(3).Info("Checking storage pool", "pool.Name", storagePool.Name)
 		if storagePool.PVCTemplate != nil {
+			poolNodes := filterNodesForStoragePool(&storagePool, usedNodes)
+			logger.V(3).Info("Nodes matching storage pool", "pool.Name", storagePool.Name, "number of nodes", len(poolNodes))
 			if isShared(storagePool.PVCTemplate) {
 				// only want to create resources if node(s) exist
-				if len(usedNodes) > 0 {
+				if len(poolNodes) > 0 {
 					// create just one shared storage pool PVC
 					if err := r.reconcileSharedStoragePoolPVC(logger, cr, namespace, &storagePool); err != nil {
 						return reconcile.Result{}, err
 					}
 					// then mount shared PVC on each node
-					for _, node := range usedNodes {
+					for _, node := range poolNodes {
 						if err := r.reconcileStoragePoolDeploymentByNode(logger, cr, namespace, &storagePool, &node, currentStoragePoolDeployments); err != nil {
 							return reconcile.Result{}, err
 						}
@@ -96,7 +107,7 @@ func (r *ReconcileHostPathProvisioner) reconcileStoragePools(logger logr.Logger,
 					}
 				}
 			} else {
-				for _, node := range usedNodes {
+				for _, node := range poolNodes {
 					if err := r.reconcileStoragePoolPVCByNode(logger, cr, namespace, &storagePool, &node); err != nil {
 						return reconcile.Result{}, err
 					}
@@ -124,6 +135,41 @@ func (r *ReconcileHostPathProvisioner) reconcileStoragePools(logger logr.Logger,
 	return reconcile.Result{}, nil
 }
 
+// nodeMatchesStoragePool returns true if the storage pool is available on the node.
+func nodeMatchesStoragePool(storagePool *hostpathprovisionerv1.StoragePool, node *corev1.Node) bool {
+	return storagePoolMatchesLabels(storagePool, node.GetLabels())
+}
+
+// storagePoolMatchesLabels returns true if the node labels match the node selector of the storage pool
+func storagePoolMatchesLabels(storagePool *hostpathprovisionerv1.StoragePool, nodeLabels map[string]string) bool {
+	return labels.SelectorFromSet(storagePool.NodeSelector).Matches(labels.Set(nodeLabels))
+}
+
+func filterNodesForStoragePool(storagePool *hostpathprovisionerv1.StoragePool, nodes []corev1.Node) []corev1.Node {
+	res := make([]corev1.Node, 0)
+	for _, node := range nodes {
+		if nodeMatchesStoragePool(storagePool, &node) {
+			res = append(res, node)
+		}
+	}
+	return res
+}
+
+// desiredStoragePoolDeploymentCount returns the number of mounter deployments the storage pools should have.
+func (r *ReconcileHostPathProvisioner) desiredStoragePoolDeploymentCount(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string) (int, error) {
+	usedNodes, err := r.getNodesByDaemonSet(logger, namespace)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, storagePool := range cr.Spec.StoragePools {
+		if storagePool.PVCTemplate != nil {
+			count += len(filterNodesForStoragePool(&storagePool, usedNodes))
+		}
+	}
+	return count, nil
+}
+
 func (r *ReconcileHostPathProvisioner) getStoragePoolForDeployment(cr *hostpathprovisionerv1.HostPathProvisioner, deployment *appsv1.Deployment) *hostpathprovisionerv1.StoragePool {
 	for _, storagePool := range cr.Spec.StoragePools {
 		if strings.HasPrefix(deployment.GetName(), fmt.Sprintf("hpp-pool-%s", storagePool.Name)) {
@@ -170,10 +216,48 @@ func (r *ReconcileHostPathProvisioner) cleanDeployments(logger logr.Logger, cr *
 	return nil
 }
 
+// withStoragePoolNodeLabel returns a copy of the labels with the node label added. Node names that aren't valid label
+// values are left out.
+func withStoragePoolNodeLabel(labels map[string]string, nodeName string) map[string]string {
+	res := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		res[k] = v
+	}
+	if len(validation.IsValidLabelValue(nodeName)) == 0 {
+		res[storagePoolNodeLabelKey] = nodeName
+	}
+	return res
+}
+
+// getStoragePoolDeploymentNodeName returns the node of a storage pool deployment. Deployments created before the node
+// label, or on nodes whose names aren't valid label values, are pinned to the node with their node affinity.
+func getStoragePoolDeploymentNodeName(deployment *appsv1.Deployment) string {
+	if nodeName, ok := deployment.GetLabels()[storagePoolNodeLabelKey]; ok {
+		return nodeName
+	}
+	affinity := deployment.Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, expression := range term.MatchExpressions {
+			if expression.Key == corev1.LabelHostname && expression.Operator == corev1.NodeSelectorOpIn && len(expression.Values) == 1 {
+				return expression.Values[0]
+			}
+		}
+	}
+	return ""
+}
+
 func (r *ReconcileHostPathProvisioner) createCleanupJobForDeployment(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, deployment *appsv1.Deployment, storagePool *hostpathprovisionerv1.StoragePool) (*corev1.Node, error) {
+	nodeName := getStoragePoolDeploymentNodeName(deployment)
+	if nodeName == "" {
+		logger.Info("Unable to determine the node of the storage pool deployment", "deployment", deployment.GetName())
+		return nil, nil
+	}
 	node := &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values[0],
+			Name: nodeName,
 		},
 	}
 	if err := r.client.Get(context.TODO(), client.ObjectKeyFromObject(node), node); err != nil {
@@ -183,6 +267,10 @@ func (r *ReconcileHostPathProvisioner) createCleanupJobForDeployment(logger logr
 		return nil, err
 	}
 	logger.V(3).Info("for node", "name", node.Name)
+	if isNodeCleanupSkipped(node) {
+		logger.Info("Skipping storage pool cleanup, the node is annotated to skip it", "storagepool.Name", storagePool.Name, "node.Name", node.GetName())
+		return node, nil
+	}
 	if err := r.createCleanupJobForNode(logger, cr, namespace, storagePool, node); err != nil && !errors.IsAlreadyExists(err) {
 		return nil, err
 	}
@@ -233,8 +321,24 @@ func (r *ReconcileHostPathProvisioner) reconcileStoragePoolPVCByNode(logger logr
 		r.recorder.Event(cr, corev1.EventTypeNormal, createResourceSuccess, fmt.Sprintf(createMessageSucceeded, desired, desired.GetName()))
 	} else if err != nil {
 		return err
+	} else if err := r.labelStoragePoolPVCNode(logger, desired, found); err != nil {
+		return err
 	}
-	return nil
+	return r.expandStoragePoolPVC(logger, cr, storagePool, found)
+}
+
+// labelStoragePoolPVCNode adds the node label to storage pool claims created before the label existed
+func (r *ReconcileHostPathProvisioner) labelStoragePoolPVCNode(logger logr.Logger, desired, found *corev1.PersistentVolumeClaim) error {
+	nodeName, ok := desired.GetLabels()[storagePoolNodeLabelKey]
+	if !ok || found.GetLabels()[storagePoolNodeLabelKey] == nodeName {
+		return nil
+	}
+	if found.GetLabels() == nil {
+		found.SetLabels(map[string]string{})
+	}
+	found.GetLabels()[storagePoolNodeLabelKey] = nodeName
+	logger.V(3).Info("Labeling storage pool pvc with its node", "pvc.Name", found.GetName(), "node.Name", nodeName)
+	return r.client.Update(context.TODO(), found)
 }
 
 func (r *ReconcileHostPathProvisioner) reconcileSharedStoragePoolPVC(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, storagePool *hostpathprovisionerv1.StoragePool) error {
@@ -258,7 +362,7 @@ func (r *ReconcileHostPathProvisioner) reconcileSharedStoragePoolPVC(logger logr
 	} else if err != nil {
 		return err
 	}
-	return nil
+	return r.expandStoragePoolPVC(logger, cr, storagePool, found)
 }
 
 func (r *ReconcileHostPathProvisioner) reconcileStoragePoolDeploymentByNode(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, storagePool *hostpathprovisionerv1.StoragePool, node *corev1.Node, currentStoragePoolDeployments map[string]appsv1.Deployment) error {
@@ -345,7 +449,7 @@ func (r *ReconcileHostPathProvisioner) currentStoragePoolDeployments(cr *hostpat
 
 func (r *ReconcileHostPathProvisioner) getNodesByDaemonSet(logger logr.Logger, namespace string) ([]corev1.Node, error) {
 	res := make([]corev1.Node, 0)
-	dsArgs := getDaemonSetArgs(logger, namespace, false)
+	dsArgs := getDaemonSetArgs(logger, nil, namespace, false)
 	ds := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: dsArgs.namespace,
@@ -378,9 +482,13 @@ func (r *ReconcileHostPathProvisioner) getNodesByDaemonSet(logger logr.Logger, n
 	}); err != nil {
 		return res, err
 	}
+	nodeDaemonSets, err := r.listNodeDaemonSets(dsArgs.namespace)
+	if err != nil {
+		return res, err
+	}
 	nodeNames := make(map[string]struct{})
 	for _, pod := range podList.Items {
-		if metav1.IsControlledBy(&pod, ds) && pod.DeletionTimestamp == nil {
+		if pod.DeletionTimestamp == nil && isControlledByDaemonSet(&pod, ds, nodeDaemonSets) {
 			nodeNames[pod.Spec.NodeName] = struct{}{}
 		}
 	}
@@ -399,6 +507,19 @@ func (r *ReconcileHostPathProvisioner) getNodesByDaemonSet(logger logr.Logger, n
 	return res, nil
 }
 
+// isControlledByDaemonSet returns true if the pod belongs to the CSI DaemonSet or one of the node DaemonSets
+func isControlledByDaemonSet(pod *corev1.Pod, ds *appsv1.DaemonSet, nodeDaemonSets []appsv1.DaemonSet) bool {
+	if metav1.IsControlledBy(pod, ds) {
+		return true
+	}
+	for _, nodeDaemonSet := range nodeDaemonSets {
+		if metav1.IsControlledBy(pod, &nodeDaemonSet) {
+			return true
+		}
+	}
+	return false
+}
+
 func Ptr[T any](v T) *T {
 	return &v
 }
@@ -430,7 +551,7 @@ func (r *ReconcileHostPathProvisioner) storagePoolPVC(storagePool *hostpathprovi
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      getStoragePoolPVCName(storagePool.Name, node.GetName()),
 				Namespace: namespace,
-				Labels:    labels,
+				Labels:    withStoragePoolNodeLabel(labels, node.GetName()),
 			},
 			Spec: *storagePool.PVCTemplate,
 		}
@@ -447,7 +568,7 @@ func (r *ReconcileHostPathProvisioner) storagePoolPVC(storagePool *hostpathprovi
 }
 
 func (r *ReconcileHostPathProvisioner) storagePoolDeploymentByNode(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, sourceStoragePool *hostpathprovisionerv1.StoragePool, namespace string, node *corev1.Node) *appsv1.Deployment {
-	args := getDaemonSetArgs(logger, namespace, false)
+	args := getDaemonSetArgs(logger, cr, namespace, false)
 	labels := util.GetRecommendedLabels()
 	resourceName := getResourceNameWithMaxLength(sourceStoragePool.Name, "hpp", maxNameLength)
 	labels[storagePoolLabelKey] = resourceName
@@ -473,9 +594,10 @@ func (r *ReconcileHostPathProvisioner) storagePoolDeploymentByNode(logger logr.L
 
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      getResourceNameWithMaxLength(hppPoolPrefix, fmt.Sprintf("%s-%s", sourceStoragePool.Name, node.GetName()), maxNameLength),
+			Name:      getStoragePoolDeploymentName(sourceStoragePool.Name, node.GetName()),
 			Namespace: namespace,
-			Labels:    labels,
+			// The node label is left out of the pod template, changing it would restart the mounter pods.
+			Labels: withStoragePoolNodeLabel(labels, node.GetName()),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Selector: &metav1.LabelSelector{
@@ -508,6 +630,8 @@ func (r *ReconcileHostPathProvisioner) storagePoolDeploymentByNode(logger logr.L
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName:            ProvisionerServiceAccountNameCsi,
+					PriorityClassName:             cr.Spec.PriorityClassName,
+					ImagePullSecrets:              cr.Spec.ImagePullSecrets,
 					RestartPolicy:                 corev1.RestartPolicyAlways,
 					SchedulerName:                 corev1.DefaultSchedulerName,
 					TerminationGracePeriodSeconds: &defaultGracePeriod,
@@ -537,7 +661,7 @@ func (r *ReconcileHostPathProvisioner) storagePoolDeploymentByNode(logger logr.L
 							Name:            "mounter",
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
 							Image:           args.operatorImage,
-							Command: []string{
+							Command: append([]string{
 								"/usr/bin/mounter",
 								"--storagePoolPath",
 								dataMountPath,
@@ -545,15 +669,49 @@ func (r *ReconcileHostPathProvisioner) storagePoolDeploymentByNode(logger logr.L
 								filepath.Join(sourceStoragePool.Path, "csi"),
 								"--hostPath",
 								"/host",
-							},
+							}, mounterLogArgs(cr, node.GetName())...),
 							SecurityContext: &corev1.SecurityContext{
 								Privileged: &privileged,
 								RunAsUser:  pointer.Int64(0),
 							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("100Mi"),
+							Resources: containerResources(getComponentResources(cr).Mounter, defaultStoragePoolRequests()),
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "http",
+									ContainerPort: mounterHTTPPort,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							// The mounter is only ready once the pool is mounted on the host from the expected source.
+							ReadinessProbe: &corev1.Probe{
+								FailureThreshold: 3,
+								TimeoutSeconds:   3,
+								PeriodSeconds:    5,
+								SuccessThreshold: 1,
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/readyz",
+										Port: intstr.IntOrString{
+											IntVal: mounterHTTPPort,
+										},
+										Scheme: corev1.URISchemeHTTP,
+									},
+								},
+							},
+							LivenessProbe: &corev1.Probe{
+								FailureThreshold:    6,
+								InitialDelaySeconds: 10,
+								TimeoutSeconds:      3,
+								PeriodSeconds:       10,
+								SuccessThreshold:    1,
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/healthz",
+										Port: intstr.IntOrString{
+											IntVal: mounterHTTPPort,
+										},
+										Scheme: corev1.URISchemeHTTP,
+									},
 								},
 							},
 							VolumeMounts: []corev1.VolumeMount{
@@ -601,10 +759,55 @@ func (r *ReconcileHostPathProvisioner) storagePoolDeploymentByNode(logger logr.L
 			Name:       dataName,
 			DevicePath: blockDataMountPath,
 		})
+		deployment.Spec.Template.Spec.Containers[0].Command = append(deployment.Spec.Template.Spec.Containers[0].Command, filesystemMounterArgs(sourceStoragePool)...)
 	}
+	if sourceStoragePool.Unmount != nil && sourceStoragePool.Unmount.OnTermination {
+		// A rolling update starts the new mounter before the old one terminates, the old mounter would unmount the pool
+		// the new one uses. The old mounter has to be gone before the new one mounts the pool.
+		deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RecreateDeploymentStrategyType,
+		}
+		// The pod has to outlive the unmount timeout, or the unmount is killed.
+		gracePeriod := int64((getUnmountTimeout(sourceStoragePool) + unmountGracePeriod).Seconds())
+		deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+		deployment.Spec.Template.Spec.Containers[0].Command = append(deployment.Spec.Template.Spec.Containers[0].Command, "--unmountOnTermination")
+		deployment.Spec.Template.Spec.Containers[0].Command = append(deployment.Spec.Template.Spec.Containers[0].Command, unmountMounterArgs(sourceStoragePool)...)
+	}
+	addEncryptionKey(&deployment.Spec.Template.Spec, sourceStoragePool)
 	return deployment
 }
 
+func getUnmountTimeout(storagePool *hostpathprovisionerv1.StoragePool) time.Duration {
+	if storagePool.Unmount == nil || storagePool.Unmount.Timeout == nil {
+		return defaultUnmountTimeout
+	}
+	return storagePool.Unmount.Timeout.Duration
+}
+
+// unmountMounterArgs returns the arguments the mounter unmounts a storage pool with
+func unmountMounterArgs(storagePool *hostpathprovisionerv1.StoragePool) []string {
+	res := []string{fmt.Sprintf("--unmountTimeout=%s", getUnmountTimeout(storagePool))}
+	if storagePool.Unmount != nil && storagePool.Unmount.Lazy {
+		res = append(res, "--lazyUnmount")
+	}
+	return res
+}
+
+// filesystemMounterArgs returns the arguments the mounter formats and mounts the device of a block storage pool with
+func filesystemMounterArgs(storagePool *hostpathprovisionerv1.StoragePool) []string {
+	res := make([]string, 0)
+	if storagePool.FSType != "" {
+		res = append(res, "--fsType", storagePool.FSType)
+	}
+	for _, option := range storagePool.MkfsOptions {
+		res = append(res, fmt.Sprintf("--mkfsOption=%s", option))
+	}
+	if len(storagePool.MountOptions) > 0 {
+		res = append(res, "--mountOptions", strings.Join(storagePool.MountOptions, ","))
+	}
+	return res
+}
+
 func getStoragePoolPVCName(poolName, nodeName string) string {
 	return getResourceNameWithMaxLength(hppPoolPrefix, fmt.Sprintf("%s-%s", poolName, nodeName), maxNameLength)
 }
@@ -613,6 +816,10 @@ func getSharedStoragePoolPVCName(poolName string) string {
 	return getResourceNameWithMaxLength(hppPoolPrefix, fmt.Sprintf("%s-shared", poolName), maxNameLength)
 }
 
+func getStoragePoolDeploymentName(poolName, nodeName string) string {
+	return getResourceNameWithMaxLength(hppPoolPrefix, fmt.Sprintf("%s-%s", poolName, nodeName), maxNameLength)
+}
+
 func (r *ReconcileHostPathProvisioner) storagePoolDeploymentsByStoragePool(cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, storagePool *hostpathprovisionerv1.StoragePool) ([]appsv1.Deployment, error) {
 	res := make([]appsv1.Deployment, 0)
 	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
@@ -643,8 +850,8 @@ func (r *ReconcileHostPathProvisioner) storagePoolDeploymentsByStoragePool(cr *h
 	return res, nil
 }
 
-func (r *ReconcileHostPathProvisioner) getClaimStatusesByStoragePool(storagePool *hostpathprovisionerv1.StoragePool, namespace string) ([]hostpathprovisionerv1.ClaimStatus, error) {
-	res := make([]hostpathprovisionerv1.ClaimStatus, 0)
+func (r *ReconcileHostPathProvisioner) getClaimsByStoragePool(storagePool *hostpathprovisionerv1.StoragePool, namespace string) ([]corev1.PersistentVolumeClaim, error) {
+	res := make([]corev1.PersistentVolumeClaim, 0)
 	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
 		MatchLabels: map[string]string{
 			"k8s-app":           MultiPurposeHostPathProvisionerName,
@@ -663,17 +870,16 @@ func (r *ReconcileHostPathProvisioner) getClaimStatusesByStoragePool(storagePool
 	}); err != nil {
 		return res, err
 	}
-	for _, pvc := range pvcList.Items {
-		res = append(res, hostpathprovisionerv1.ClaimStatus{
-			Name:   pvc.GetName(),
-			Status: pvc.Status,
-		})
-	}
-
-	return res, nil
+	return pvcList.Items, nil
 }
 
-func (r *ReconcileHostPathProvisioner) reconcileStoragePoolStatus(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string) error {
+// reconcileStoragePoolStatus updates the storage pool statuses from the state of the pools on their nodes. A pool that
+// is not ready on a node is reported in its phase, it doesn't fail the reconcile.
+func (r *ReconcileHostPathProvisioner) reconcileStoragePoolStatus(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, nodeStatuses nodeStoragePoolStatuses) error {
+	previousPhases := make(map[string]hostpathprovisionerv1.StoragePoolPhase)
+	for _, poolStatus := range cr.Status.StoragePoolStatuses {
+		previousPhases[poolStatus.Name] = poolStatus.Phase
+	}
 	// Check the template of the storage pool
 	newStoragePoolStatuses := make([]hostpathprovisionerv1.StoragePoolStatus, 0)
 	if cr.Spec.PathConfig != nil {
@@ -696,21 +902,18 @@ func (r *ReconcileHostPathProvisioner) reconcileStoragePoolStatus(logger logr.Lo
 					}
 				}
 				logger.V(5).WithName("Status").Info("Number of deployments for pool ready", "storage pool", storagePool.Name, "deployment count", currentReady)
-				claimStatuses, err := r.getClaimStatusesByStoragePool(&storagePool, namespace)
-				if err != nil {
-					return err
-				}
-				for _, s := range claimStatuses {
-					if phase := s.Status.Phase; phase != corev1.ClaimBound {
-						return fmt.Errorf("error: Pool PVC %s is %s instead of %s", s.Name, phase, corev1.ClaimBound)
-					}
+				phase, reason, message := storagePoolPhase(nodeStatuses[storagePool.Name])
+				if phase == hostpathprovisionerv1.StoragePoolFailed && previousPhases[storagePool.Name] != phase {
+					r.recorder.Event(cr, corev1.EventTypeWarning, storagePoolFailedEvent, fmt.Sprintf("Storage pool %s failed: %s", storagePool.Name, message))
 				}
 				newStoragePoolStatuses = append(newStoragePoolStatuses, hostpathprovisionerv1.StoragePoolStatus{
-					Name:          storagePool.Name,
-					Phase:         hostpathprovisionerv1.StoragePoolReady,
-					DesiredReady:  len(deployments),
-					CurrentReady:  currentReady,
-					ClaimStatuses: claimStatuses,
+					Name:         storagePool.Name,
+					Phase:        phase,
+					Reason:       reason,
+					Message:      message,
+					DesiredReady: len(deployments),
+					CurrentReady: currentReady,
+					Encrypted:    storagePool.Encryption != nil,
 				})
 			} else {
 				newStoragePoolStatuses = append(newStoragePoolStatuses, hostpathprovisionerv1.StoragePoolStatus{
@@ -727,20 +930,47 @@ func (r *ReconcileHostPathProvisioner) reconcileStoragePoolStatus(logger logr.Lo
 	return nil
 }
 
-func (r *ReconcileHostPathProvisioner) hasCleanUpFinished(namespace string) (bool, error) {
+// hasCleanUpFinished returns true once no cleanup Job is running. Failed Jobs don't block the cleanup, they are
+// reported in the status and the CleanupFailed condition of the CR. Jobs on nodes that are gone or skip the cleanup are
+// not waited for.
+func (r *ReconcileHostPathProvisioner) hasCleanUpFinished(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string) (bool, error) {
 	jobs, err := r.getCleanUpJobs(namespace)
 	if err != nil {
 		return false, err
 	}
 	finished := true
+	failures := make([]hostpathprovisionerv1.CleanupFailure, 0)
+	succeeded := make(map[cleanupTarget]bool)
 	for _, job := range jobs {
+		skipped, err := r.isCleanupJobSkipped(&job)
+		if err != nil {
+			return false, err
+		}
+		if skipped {
+			logger.V(3).Info("Not waiting for cleanup job, the node is gone or skips the cleanup", "name", job.GetName())
+			continue
+		}
+		if failure := cleanupJobFailure(&job); failure != nil {
+			failures = append(failures, *failure)
+			continue
+		}
 		if job.Status.Succeeded == int32(0) {
 			finished = false
+			continue
 		}
+		succeeded[getCleanupJobTarget(&job)] = true
+	}
+	failures, err = r.mergeCleanupFailures(cr, failures, succeeded)
+	if err != nil {
+		return false, err
 	}
+	r.reportCleanupFailures(cr, failures)
 	return finished, nil
 }
 
+// removeCleanUpJobs deletes the finished cleanup Jobs. Failed Jobs are kept for inspection until they expire, unless
+// their node is gone or skips the cleanup. They are owned by the CR, so they are garbage collected with it. Their
+// failures are recorded in the status of the CR, so they are still reported once the Jobs are gone.
 func (r *ReconcileHostPathProvisioner) removeCleanUpJobs(logger logr.Logger, namespace string) error {
 	deletePropagationBackground := metav1.DeletePropagationBackground
 	jobs, err := r.getCleanUpJobs(namespace)
@@ -749,6 +979,16 @@ func (r *ReconcileHostPathProvisioner) removeCleanUpJobs(logger logr.Logger, nam
 	}
 	logger.V(3).Info("Found jobs", "count", len(jobs))
 	for _, job := range jobs {
+		if cleanupJobFailure(&job) != nil {
+			skipped, err := r.isCleanupJobSkipped(&job)
+			if err != nil {
+				return err
+			}
+			if !skipped {
+				logger.V(3).Info("Keeping failed job", "name", job.GetName())
+				continue
+			}
+		}
 		logger.V(3).Info("Deleting job", "name", job.GetName())
 		if err := r.client.Delete(context.TODO(), &job, &client.DeleteOptions{
 			PropagationPolicy: &deletePropagationBackground,
@@ -779,23 +1019,35 @@ func (r *ReconcileHostPathProvisioner) getCleanUpJobs(namespace string) ([]batch
 }
 
 func (r *ReconcileHostPathProvisioner) createCleanupJobForNode(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, namespace string, sourceStoragePool *hostpathprovisionerv1.StoragePool, node *corev1.Node) error {
-	args := getDaemonSetArgs(logger, namespace, false)
+	args := getDaemonSetArgs(logger, cr, namespace, false)
 	labels := util.GetRecommendedLabels()
+	// The Job is labeled with its storage pool and node so changes to it are watched, its pod keeps the common labels.
+	jobLabels := withStoragePoolNodeLabel(labels, node.GetName())
+	jobLabels[storagePoolLabelKey] = getResourceNameWithMaxLength(sourceStoragePool.Name, "hpp", maxNameLength)
 	directory := corev1.HostPathDirectory
 	bidirectional := corev1.MountPropagationBidirectional
 	cleanupJob := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      getResourceNameWithMaxLength("cleanup-pool", fmt.Sprintf("%s-%s", sourceStoragePool.Name, node.GetName()), maxNameLength),
 			Namespace: namespace,
-			Labels:    labels,
+			Labels:    jobLabels,
+			Annotations: map[string]string{
+				cleanupNodeAnnotation:        node.GetName(),
+				cleanupStoragePoolAnnotation: sourceStoragePool.Name,
+			},
 		},
 		Spec: batchv1.JobSpec{
+			BackoffLimit:            pointer.Int32(cleanupJobBackoffLimit),
+			ActiveDeadlineSeconds:   pointer.Int64(int64(cleanupJobActiveDeadline(sourceStoragePool).Seconds())),
+			TTLSecondsAfterFinished: pointer.Int32(int32(cleanupJobTTL.Seconds())),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: labels,
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName:            ProvisionerServiceAccountNameCsi,
+					PriorityClassName:             cr.Spec.PriorityClassName,
+					ImagePullSecrets:              cr.Spec.ImagePullSecrets,
 					RestartPolicy:                 corev1.RestartPolicyOnFailure,
 					SchedulerName:                 corev1.DefaultSchedulerName,
 					TerminationGracePeriodSeconds: pointer.Int64(30),
@@ -825,24 +1077,19 @@ func (r *ReconcileHostPathProvisioner) createCleanupJobForNode(logger logr.Logge
 							Name:            "mounter",
 							ImagePullPolicy: cr.Spec.ImagePullPolicy,
 							Image:           args.operatorImage,
-							Command: []string{
+							Command: append([]string{
 								"/usr/bin/mounter",
 								"--mountPath",
 								filepath.Join(sourceStoragePool.Path, "csi"),
 								"--hostPath",
 								"/host",
 								"--unmount",
-							},
+							}, mounterLogArgs(cr, node.GetName())...),
 							SecurityContext: &corev1.SecurityContext{
 								Privileged: pointer.Bool(true),
 								RunAsUser:  pointer.Int64(0),
 							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("100Mi"),
-								},
-							},
+							Resources: containerResources(getComponentResources(cr).Cleanup, defaultStoragePoolRequests()),
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:             "host-root",
@@ -869,9 +1116,17 @@ func (r *ReconcileHostPathProvisioner) createCleanupJobForNode(logger logr.Logge
 			},
 		},
 	}
+	cleanupJob.Spec.Template.Spec.Containers[0].Command = append(cleanupJob.Spec.Template.Spec.Containers[0].Command, unmountMounterArgs(sourceStoragePool)...)
+	addEncryptionCleanup(&cleanupJob.Spec.Template.Spec, sourceStoragePool)
+	// The Job is garbage collected with the CR, whether it is still running or failed and kept for inspection.
+	if err := controllerutil.SetControllerReference(cr, cleanupJob, r.scheme); err != nil {
+		return err
+	}
 	logger.V(3).Info("Creating cleanup job", "name", cleanupJob.Name)
 	if err := r.client.Create(context.TODO(), cleanupJob); err != nil && !errors.IsAlreadyExists(err) {
 		logger.Error(err, "Unable to create cleanup job", "name", cleanupJob.GetName())
+		r.recorder.Event(cr, corev1.EventTypeWarning, createResourceFailed, fmt.Sprintf(createMessageFailed, cleanupJob.GetName(), err))
+		return err
 	}
 	return nil
 }
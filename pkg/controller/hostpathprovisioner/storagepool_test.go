@@ -18,6 +18,8 @@ package hostpathprovisioner
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	ginkgo "github.com/onsi/ginkgo/v2"
 	gomega "github.com/onsi/gomega"
@@ -26,6 +28,8 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
@@ -98,6 +102,239 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 			verifyDeploymentsAndPVCs(4, 10, cr, r, cl)
 		})
 
+		ginkgo.It("Should only create storage pools on nodes matching the storage pool node selector", func() {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.StoragePools[0].NodeSelector = map[string]string{"disk": "nvme"}
+			cr, r, cl := createDeployedCr(cr)
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "test-name",
+					Namespace: testNamespace,
+				},
+			}
+			addNodesToCluster(1, 4, cl)
+			setNodeLabels(1, 2, map[string]string{"disk": "nvme"}, cl)
+			csiDs := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName),
+					Namespace: testNamespace,
+				},
+			}
+			err := cl.Get(context.TODO(), client.ObjectKeyFromObject(csiDs), csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			// The CSI driver only gets the storage pool on the matching nodes
+			gomega.Expect(csiDs.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(gomega.Equal([]corev1.NodeSelectorTerm{
+				{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "disk", Operator: corev1.NodeSelectorOpIn, Values: []string{"nvme"}},
+					},
+				},
+			}))
+			csiDs.Status.DesiredNumberScheduled = int32(4)
+			csiDs.Status.NumberAvailable = int32(4)
+			csiDs.Status.NumberReady = int32(4)
+			err = cl.Status().Update(context.TODO(), csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			createCsiDsPods(1, 4, csiDs, cl)
+			res, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(res.RequeueAfter).To(gomega.Equal(storagePoolNotReadyRequeueInterval))
+			bindAllPVCs(cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			// Expect 2 pods and 2 pvcs, only node1 and node2 match
+			verifyDeploymentsAndPVCs(2, 2, cr, r, cl)
+
+			ginkgo.By("Removing the label from node2, it should remove the deployment and clean up the node")
+			setNodeLabels(2, 2, nil, cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			verifyDeploymentsAndPVCs(1, 2, cr, r, cl)
+			jobList := &batchv1.JobList{}
+			err = r.client.List(context.TODO(), jobList)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(jobList.Items).To(gomega.HaveLen(1))
+			gomega.Expect(jobList.Items[0].GetName()).To(gomega.Equal("cleanup-pool-local-node2"))
+		})
+
+		ginkgo.It("Should only advertise the storage pools matching the nodes of a CSI DaemonSet", func() {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.StoragePools[0].NodeSelector = map[string]string{"disk": "nvme"}
+			cr.Spec.StoragePools = append(cr.Spec.StoragePools,
+				hppv1.StoragePool{Name: "basic", Path: "/var/basic"},
+				hppv1.StoragePool{Name: "ssd", Path: "/var/ssd", NodeSelector: map[string]string{"disk": "ssd"}},
+			)
+			cr, r, cl := createDeployedCr(cr)
+			addNodesToCluster(1, 4, cl)
+			setNodeLabels(1, 1, map[string]string{"disk": "nvme"}, cl)
+			setNodeLabels(2, 2, map[string]string{"disk": "ssd"}, cl)
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			storagePoolsArg := func(ds *appsv1.DaemonSet) string {
+				for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+					if env.Name == "PV_DIR" {
+						return env.Value
+					}
+				}
+				return ""
+			}
+			getNodeNames := func(ds *appsv1.DaemonSet) []string {
+				return ds.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchFields[0].Values
+			}
+
+			csiDs := &appsv1.DaemonSet{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(csiDs.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(gomega.ConsistOf(
+				corev1.NodeSelectorRequirement{Key: "disk", Operator: corev1.NodeSelectorOpIn, Values: []string{"nvme"}},
+				corev1.NodeSelectorRequirement{Key: "disk", Operator: corev1.NodeSelectorOpIn, Values: []string{"ssd"}},
+			))
+
+			groups, err := r.getStoragePoolNodeGroups(cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(groups).To(gomega.HaveLen(3))
+			expected := map[string][]string{
+				"":      {"node3", "node4"},
+				"local": {"node1"},
+				"ssd":   {"node2"},
+			}
+			for _, group := range groups {
+				nodeNames, ok := expected[strings.Join(group.storagePools, ",")]
+				gomega.Expect(ok).To(gomega.BeTrue())
+				groupDs := &appsv1.DaemonSet{}
+				err = cl.Get(context.TODO(), client.ObjectKey{Name: group.daemonSetName(), Namespace: testNamespace}, groupDs)
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				gomega.Expect(getNodeNames(groupDs)).To(gomega.Equal(nodeNames))
+				gomega.Expect(groupDs.GetLabels()).To(gomega.HaveKeyWithValue(nodeDaemonSetLabel, groupDs.GetName()))
+				gomega.Expect(storagePoolsArg(groupDs)).To(gomega.ContainSubstring(`"name":"basic"`))
+				for _, poolName := range []string{"local", "ssd"} {
+					if len(group.storagePools) > 0 && group.storagePools[0] == poolName {
+						gomega.Expect(storagePoolsArg(groupDs)).To(gomega.ContainSubstring(fmt.Sprintf(`"name":"%s"`, poolName)))
+					} else {
+						gomega.Expect(storagePoolsArg(groupDs)).ToNot(gomega.ContainSubstring(fmt.Sprintf(`"name":"%s"`, poolName)))
+					}
+				}
+			}
+
+			ginkgo.By("Labeling node3, it should move to the group of the pool")
+			setNodeLabels(3, 3, map[string]string{"disk": "nvme"}, cl)
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			groupDs := &appsv1.DaemonSet{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: (&storagePoolNodeGroup{storagePools: []string{"local"}}).daemonSetName(), Namespace: testNamespace}, groupDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(getNodeNames(groupDs)).To(gomega.Equal([]string{"node1", "node3"}))
+
+			ginkgo.By("Removing the node selectors, it should remove the group DaemonSets")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			for i := range cr.Spec.StoragePools {
+				cr.Spec.StoragePools[i].NodeSelector = nil
+			}
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			nodeDaemonSets, err := r.listNodeDaemonSets(testNamespace)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(nodeDaemonSets).To(gomega.BeEmpty())
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(csiDs), csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(csiDs.Spec.Template.Spec.Affinity).To(gomega.BeNil())
+		})
+
+		ginkgo.It("Should be ready when only the node DaemonSets run pods", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			csiDs := &appsv1.DaemonSet{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), Namespace: testNamespace}, csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			csiDs.Status = appsv1.DaemonSetStatus{}
+			nodeDs := csiDs.DeepCopy()
+			nodeDs.ResourceVersion = ""
+			setNodeDaemonSet(nodeDs, getNodeDaemonSetName("node1"), []string{"node1"})
+			nodeDs.Status = appsv1.DaemonSetStatus{DesiredNumberScheduled: 1}
+			gomega.Expect(cl.Create(context.TODO(), nodeDs)).To(gomega.Succeed())
+			ready, err := r.checkCSIDaemonSetsReady(cr, testNamespace, csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.BeFalse())
+
+			nodeDs.Status.NumberReady = 1
+			gomega.Expect(cl.Status().Update(context.TODO(), nodeDs)).To(gomega.Succeed())
+			ready, err = r.checkCSIDaemonSetsReady(cr, testNamespace, csiDs)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should label the storage pool objects of a node with the node", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "test-name",
+					Namespace: testNamespace,
+				},
+			}
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.GetLabels()).To(gomega.HaveKeyWithValue(storagePoolNodeLabelKey, "node1"))
+			gomega.Expect(deployment.Spec.Template.GetLabels()).ToNot(gomega.HaveKey(storagePoolNodeLabelKey))
+			nodeStoragePool := &hppv1.NodeStoragePool{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: getNodeStoragePoolName("local", "node1")}, nodeStoragePool)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(nodeStoragePool.GetLabels()).To(gomega.HaveKeyWithValue(storagePoolNodeLabelKey, "node1"))
+
+			ginkgo.By("Removing the label from the pvc, it should be added back")
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolPVCName("local", "node1"), Namespace: testNamespace}, pvc)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(pvc.GetLabels()).To(gomega.HaveKeyWithValue(storagePoolNodeLabelKey, "node1"))
+			delete(pvc.Labels, storagePoolNodeLabelKey)
+			gomega.Expect(cl.Update(context.TODO(), pvc)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(pvc), pvc)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(pvc.GetLabels()).To(gomega.HaveKeyWithValue(storagePoolNodeLabelKey, "node1"))
+		})
+
+		ginkgo.It("Should find the node of storage pool deployments without the node label", func() {
+			deployment := &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Affinity: &corev1.Affinity{
+								NodeAffinity: &corev1.NodeAffinity{
+									RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+										NodeSelectorTerms: []corev1.NodeSelectorTerm{
+											{
+												MatchExpressions: []corev1.NodeSelectorRequirement{
+													{
+														Key:      corev1.LabelHostname,
+														Operator: corev1.NodeSelectorOpIn,
+														Values:   []string{"node1"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			gomega.Expect(getStoragePoolDeploymentNodeName(deployment)).To(gomega.Equal("node1"))
+			deployment.SetLabels(map[string]string{storagePoolNodeLabelKey: "node2"})
+			gomega.Expect(getStoragePoolDeploymentNodeName(deployment)).To(gomega.Equal("node2"))
+			gomega.Expect(getStoragePoolDeploymentNodeName(&appsv1.Deployment{})).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should leave out node names that are not valid label values", func() {
+			labels := map[string]string{"foo": "bar"}
+			gomega.Expect(withStoragePoolNodeLabel(labels, "node1")).To(gomega.Equal(map[string]string{"foo": "bar", storagePoolNodeLabelKey: "node1"}))
+			gomega.Expect(withStoragePoolNodeLabel(labels, strings.Repeat("n", 64))).To(gomega.Equal(map[string]string{"foo": "bar"}))
+			gomega.Expect(labels).To(gomega.HaveLen(1))
+		})
+
 		ginkgo.It("Should fix modified storage pool deployments", func() {
 			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
 			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
@@ -164,6 +401,303 @@ var _ = ginkgo.Describe("Controller reconcile loop", func() {
 			gomega.Expect(jobList.Items[0].Spec.Template.Spec.Containers[0].SecurityContext.Privileged).To(gomega.Equal(pointer.Bool(true)))
 		})
 
+		ginkgo.It("Should apply the configured resources to the mounter deployments and cleanup jobs", func() {
+			mounterResources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("20m"),
+					corev1.ResourceMemory: resource.MustParse("50Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("50Mi"),
+				},
+			}
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.Resources = &hppv1.ComponentResources{
+				Mounter: mounterResources.DeepCopy(),
+				Cleanup: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+			}
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(gomega.Equal(mounterResources))
+
+			ginkgo.By("Marking CR as deleted, the cleanup job should use the cleanup resources")
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Delete(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			jobList := &batchv1.JobList{}
+			gomega.Expect(cl.List(context.TODO(), jobList)).To(gomega.Succeed())
+			gomega.Expect(jobList.Items).To(gomega.HaveLen(1))
+			gomega.Expect(jobList.Items[0].Spec.Template.Spec.Containers[0].Resources).To(gomega.Equal(corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10m"),
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			}))
+		})
+
+		ginkgo.It("Should apply the priority class, image pull secrets and mounter image to the mounter deployments", func() {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.PriorityClassName = "system-node-critical"
+			cr.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "mirror-pull-secret"}}
+			cr.Spec.Images = &hppv1.ComponentImages{
+				Mounter: "mirror.example.com/hostpath-provisioner-operator:hotfix",
+			}
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.PriorityClassName).To(gomega.Equal("system-node-critical"))
+			gomega.Expect(deployment.Spec.Template.Spec.ImagePullSecrets).To(gomega.Equal(cr.Spec.ImagePullSecrets))
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal("mirror.example.com/hostpath-provisioner-operator:hotfix"))
+		})
+
+		ginkgo.It("Should probe the health and readiness of the mounter", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			gomega.Expect(container.Ports).To(gomega.ContainElement(gomega.HaveField("ContainerPort", int32(mounterHTTPPort))))
+			gomega.Expect(container.ReadinessProbe).ToNot(gomega.BeNil())
+			gomega.Expect(container.ReadinessProbe.HTTPGet.Path).To(gomega.Equal("/readyz"))
+			gomega.Expect(container.ReadinessProbe.HTTPGet.Port.IntValue()).To(gomega.Equal(mounterHTTPPort))
+			gomega.Expect(container.LivenessProbe).ToNot(gomega.BeNil())
+			gomega.Expect(container.LivenessProbe.HTTPGet.Path).To(gomega.Equal("/healthz"))
+			gomega.Expect(container.LivenessProbe.HTTPGet.Port.IntValue()).To(gomega.Equal(mounterHTTPPort))
+
+			ginkgo.By("Not probing the cleanup job")
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Delete(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			jobList := &batchv1.JobList{}
+			gomega.Expect(cl.List(context.TODO(), jobList)).To(gomega.Succeed())
+			gomega.Expect(jobList.Items).To(gomega.HaveLen(1))
+			gomega.Expect(jobList.Items[0].Spec.Template.Spec.Containers[0].ReadinessProbe).To(gomega.BeNil())
+			gomega.Expect(jobList.Items[0].Spec.Template.Spec.Containers[0].LivenessProbe).To(gomega.BeNil())
+		})
+
+		ginkgo.It("Should pass the unmount settings to the mounter and the cleanup job", func() {
+			cr := createStoragePoolWithTemplateCr()
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Command).ToNot(gomega.ContainElement("--unmountOnTermination"))
+			gomega.Expect(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(gomega.Equal(pointer.Int64(30)))
+			gomega.Expect(deployment.Spec.Strategy.Type).To(gomega.Equal(appsv1.RollingUpdateDeploymentStrategyType))
+
+			ginkgo.By("Unmounting the pool when the mounter terminates")
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			cr.Spec.StoragePools[0].Unmount = &hppv1.StoragePoolUnmount{
+				OnTermination: true,
+				Timeout:       &metav1.Duration{Duration: 2 * time.Minute},
+				Lazy:          true,
+			}
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(deployment), deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(gomega.ContainElements("--unmountOnTermination", "--unmountTimeout=2m0s", "--lazyUnmount"))
+			gomega.Expect(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(gomega.Equal(pointer.Int64(150)))
+			// The old mounter would unmount the pool of the new mounter during a rolling update
+			gomega.Expect(deployment.Spec.Strategy).To(gomega.Equal(appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}))
+
+			ginkgo.By("Marking CR as deleted, the cleanup job should use the unmount settings")
+			err = cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Delete(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			jobList := &batchv1.JobList{}
+			gomega.Expect(cl.List(context.TODO(), jobList)).To(gomega.Succeed())
+			gomega.Expect(jobList.Items).To(gomega.HaveLen(1))
+			command := jobList.Items[0].Spec.Template.Spec.Containers[0].Command
+			gomega.Expect(command).To(gomega.ContainElements("--unmount", "--unmountTimeout=2m0s", "--lazyUnmount"))
+			gomega.Expect(command).ToNot(gomega.ContainElement("--unmountOnTermination"))
+		})
+
+		ginkgo.It("Should apply the mounter log verbosity to the mounter deployments", func() {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.LogVerbosity = &hppv1.LogVerbosity{
+				Components: &hppv1.ComponentLogVerbosity{
+					Mounter: pointer.Int32(4),
+				},
+				Nodes: []hppv1.NodeLogVerbosity{
+					{
+						NodeName: "node2",
+						Default:  pointer.Int32(0),
+					},
+				},
+			}
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(gomega.ContainElement("--zap-log-level=4"))
+			err = cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node2"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(gomega.ContainElement("--zap-log-level=info"))
+		})
+
+		ginkgo.It("Should pass the filesystem type, mkfs options and mount options to the mounter", func() {
+			cr := createStoragePoolWithTemplateBlockCr()
+			cr.Spec.StoragePools[0].FSType = hppv1.FSTypeExt4
+			cr.Spec.StoragePools[0].MkfsOptions = []string{"-m", "1"}
+			cr.Spec.StoragePools[0].MountOptions = []string{"prjquota", "noatime"}
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(gomega.Equal([]string{
+				"/usr/bin/mounter",
+				"--storagePoolPath",
+				blockDataMountPath,
+				"--mountPath",
+				"/tmp/test/csi",
+				"--hostPath",
+				"/host",
+				"--fsType",
+				"ext4",
+				"--mkfsOption=-m",
+				"--mkfsOption=1",
+				"--mountOptions",
+				"prjquota,noatime",
+			}))
+		})
+
+		ginkgo.It("Should pass the encryption key to the mounter and close the device in the cleanup job", func() {
+			cr := createStoragePoolWithTemplateBlockCr()
+			cr.Spec.StoragePools[0].Encryption = &hppv1.StoragePoolEncryption{
+				SecretName: "pool-key",
+			}
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			deployment := &appsv1.Deployment{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", "node1"), Namespace: testNamespace}, deployment)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			gomega.Expect(container.Command).To(gomega.ContainElements("--luksName", "hpp-pool-local", "--encryptionKeyFile", "/etc/hpp/encryption/key"))
+			gomega.Expect(container.VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{
+				Name:      encryptionKeyVolumeName,
+				MountPath: encryptionKeyMountPath,
+				ReadOnly:  true,
+			}))
+			gomega.Expect(deployment.Spec.Template.Spec.Volumes).To(gomega.ContainElement(corev1.Volume{
+				Name: encryptionKeyVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName:  "pool-key",
+						Items:       []corev1.KeyToPath{{Key: "key", Path: "key"}},
+						DefaultMode: pointer.Int32(0400),
+					},
+				},
+			}))
+
+			ginkgo.By("Reporting the storage pool as encrypted")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.StoragePoolStatuses).To(gomega.HaveLen(1))
+			gomega.Expect(cr.Status.StoragePoolStatuses[0].Encrypted).To(gomega.BeTrue())
+
+			ginkgo.By("Marking CR as deleted, the cleanup job should close the device")
+			gomega.Expect(cl.Delete(context.TODO(), cr)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			jobList := &batchv1.JobList{}
+			gomega.Expect(cl.List(context.TODO(), jobList)).To(gomega.Succeed())
+			gomega.Expect(jobList.Items).To(gomega.HaveLen(1))
+			command := jobList.Items[0].Spec.Template.Spec.Containers[0].Command
+			gomega.Expect(command).To(gomega.ContainElements("--unmount", "--luksName", "hpp-pool-local"))
+			gomega.Expect(command).ToNot(gomega.ContainElement("--encryptionKeyFile"))
+		})
+
+		ginkgo.It("Should expand the pool PVCs when the PVC template size is increased", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateBlockCr())
+			createPoolStorageClass(true, cl)
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			setPVCCapacities(resource.MustParse("1Gi"), cl)
+
+			ginkgo.By("Increasing the size of the PVC template")
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.StoragePools[0].PVCTemplate.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			reconcileStoragePools(r)
+			pvcList := &corev1.PersistentVolumeClaimList{}
+			gomega.Expect(cl.List(context.TODO(), pvcList, client.InNamespace(testNamespace))).To(gomega.Succeed())
+			gomega.Expect(pvcList.Items).To(gomega.HaveLen(2))
+			for _, pvc := range pvcList.Items {
+				gomega.Expect(pvc.Spec.Resources.Requests.Storage().String()).To(gomega.Equal("2Gi"))
+			}
+			verifyExpandingCondition(cr, cl, metav1.ConditionTrue, expandingReasonInProgress)
+			nodeStoragePool := &hppv1.NodeStoragePool{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: getNodeStoragePoolName("local", "node1")}, nodeStoragePool)).To(gomega.Succeed())
+			gomega.Expect(nodeStoragePool.Status.Expansion).To(gomega.Equal(hppv1.StoragePoolExpansionInProgress))
+			gomega.Expect(nodeStoragePool.Status.ClaimCapacity.String()).To(gomega.Equal("1Gi"))
+
+			ginkgo.By("Completing the expansion once the PVCs have the new capacity")
+			setPVCCapacities(resource.MustParse("2Gi"), cl)
+			reconcileStoragePools(r)
+			verifyExpandingCondition(cr, cl, metav1.ConditionFalse, expandingReasonComplete)
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(nodeStoragePool), nodeStoragePool)).To(gomega.Succeed())
+			gomega.Expect(nodeStoragePool.Status.Expansion).To(gomega.BeEmpty())
+			gomega.Expect(nodeStoragePool.Status.ClaimCapacity.String()).To(gomega.Equal("2Gi"))
+		})
+
+		ginkgo.It("Should not expand the pool PVCs if the storage class doesn't allow expansion", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateBlockCr())
+			createPoolStorageClass(false, cl)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			setPVCCapacities(resource.MustParse("1Gi"), cl)
+
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.StoragePools[0].PVCTemplate.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			reconcileStoragePools(r)
+			pvc := &corev1.PersistentVolumeClaim{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolPVCName("local", "node1"), Namespace: testNamespace}, pvc)).To(gomega.Succeed())
+			gomega.Expect(pvc.Spec.Resources.Requests.Storage().String()).To(gomega.Equal("1Gi"))
+			verifyExpandingCondition(cr, cl, metav1.ConditionFalse, expandingReasonNotSupported)
+			nodeStoragePool := &hppv1.NodeStoragePool{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: getNodeStoragePoolName("local", "node1")}, nodeStoragePool)).To(gomega.Succeed())
+			gomega.Expect(nodeStoragePool.Status.Expansion).To(gomega.Equal(hppv1.StoragePoolExpansionNotSupported))
+		})
+
+		ginkgo.It("Should not shrink the pool PVCs when the PVC template size is decreased", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateBlockCr())
+			createPoolStorageClass(true, cl)
+			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
+			setPVCCapacities(resource.MustParse("1Gi"), cl)
+
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+			cr.Spec.StoragePools[0].PVCTemplate.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("512Mi")
+			gomega.Expect(cl.Update(context.TODO(), cr)).To(gomega.Succeed())
+			reconcileStoragePools(r)
+			pvc := &corev1.PersistentVolumeClaim{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolPVCName("local", "node1"), Namespace: testNamespace}, pvc)).To(gomega.Succeed())
+			gomega.Expect(pvc.Spec.Resources.Requests.Storage().String()).To(gomega.Equal("1Gi"))
+			verifyExpandingCondition(cr, cl, metav1.ConditionFalse, expandingReasonComplete)
+		})
+
 		ginkgo.It("Should not panic when node is deleted before cleanup", func() {
 			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
 			scaleClusterNodesAndDsUp(1, 1, cr, r, cl)
@@ -295,22 +829,23 @@ func scaleClusterNodesAndDsUp(start, end int, cr *hppv1.HostPathProvisioner, r *
 	createCsiDsPods(start, end, csiDs, cl)
 
 	ginkgo.By("reconciling again, it should create storage pools")
-	_, err = r.Reconcile(context.TODO(), req)
-	gomega.Expect(err).To(gomega.HaveOccurred())
-	gomega.Expect(err.Error()).To(gomega.ContainSubstring("instead of Bound"))
-	err = r.client.Get(context.TODO(), req.NamespacedName, cr)
+	res, err := r.Reconcile(context.TODO(), req)
 	gomega.Expect(err).ToNot(gomega.HaveOccurred())
-	gomega.Expect(IsHppAvailable(cr)).To(gomega.BeFalse())
-	pvcList := &corev1.PersistentVolumeClaimList{}
-	err = cl.List(context.TODO(), pvcList, &client.ListOptions{
-		Namespace: testNamespace,
-	})
+	gomega.Expect(res.RequeueAfter).To(gomega.Equal(storagePoolNotReadyRequeueInterval))
+	err = r.client.Get(context.TODO(), req.NamespacedName, cr)
 	gomega.Expect(err).ToNot(gomega.HaveOccurred())
-	for _, pvc := range pvcList.Items {
-		pvc.Status.Phase = corev1.ClaimBound
-		err = cl.Status().Update(context.TODO(), &pvc)
-		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	gomega.Expect(IsHppAvailable(cr)).To(gomega.BeTrue())
+	for _, storagePool := range cr.Spec.StoragePools {
+		if storagePool.PVCTemplate == nil {
+			continue
+		}
+		gomega.Expect(cr.Status.StoragePoolStatuses).To(gomega.ContainElement(gomega.And(
+			gomega.HaveField("Name", storagePool.Name),
+			gomega.HaveField("Phase", hppv1.StoragePoolPreparing),
+			gomega.HaveField("Reason", storagePoolReasonClaimPending),
+		)))
 	}
+	bindAllPVCs(cl)
 	_, err = r.Reconcile(context.TODO(), req)
 	gomega.Expect(err).ToNot(gomega.HaveOccurred())
 	err = r.client.Get(context.TODO(), req.NamespacedName, cr)
@@ -460,6 +995,70 @@ func addNodesToCluster(start, end int, cl client.Client) {
 	}
 }
 
+func setNodeLabels(start, end int, labels map[string]string, cl client.Client) {
+	for i := start; i <= end; i++ {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("node%d", i),
+			},
+		}
+		err := cl.Get(context.TODO(), client.ObjectKeyFromObject(node), node)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		node.Labels = labels
+		err = cl.Update(context.TODO(), node)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	}
+}
+
+func bindAllPVCs(cl client.Client) {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	err := cl.List(context.TODO(), pvcList, &client.ListOptions{
+		Namespace: testNamespace,
+	})
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	for _, pvc := range pvcList.Items {
+		pvc.Status.Phase = corev1.ClaimBound
+		err = cl.Status().Update(context.TODO(), &pvc)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	}
+}
+
+func createPoolStorageClass(allowVolumeExpansion bool, cl client.Client) {
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Provisioner:          "test.provisioner",
+		AllowVolumeExpansion: &allowVolumeExpansion,
+	}
+	gomega.Expect(cl.Create(context.TODO(), storageClass)).To(gomega.Succeed())
+}
+
+func setPVCCapacities(capacity resource.Quantity, cl client.Client) {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	gomega.Expect(cl.List(context.TODO(), pvcList, client.InNamespace(testNamespace))).To(gomega.Succeed())
+	for _, pvc := range pvcList.Items {
+		pvc.Status.Capacity = corev1.ResourceList{
+			corev1.ResourceStorage: capacity,
+		}
+		gomega.Expect(cl.Status().Update(context.TODO(), &pvc)).To(gomega.Succeed())
+	}
+}
+
+func reconcileStoragePools(r *ReconcileHostPathProvisioner) {
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-name", Namespace: testNamespace}})
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+}
+
+func verifyExpandingCondition(cr *hppv1.HostPathProvisioner, cl client.Client, status metav1.ConditionStatus, reason string) {
+	gomega.Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cr), cr)).To(gomega.Succeed())
+	gomega.Expect(cr.Status.StoragePoolStatuses).To(gomega.HaveLen(1))
+	condition := meta.FindStatusCondition(cr.Status.StoragePoolStatuses[0].Conditions, hppv1.StoragePoolExpanding)
+	gomega.Expect(condition).ToNot(gomega.BeNil())
+	gomega.Expect(condition.Status).To(gomega.Equal(status))
+	gomega.Expect(condition.Reason).To(gomega.Equal(reason))
+}
+
 func removeNodesFromCluster(start, end int, cl client.Client) {
 	for i := start; i <= end; i++ {
 		node := &corev1.Node{
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+const (
+	// csiStorageCapacityDriverLabel is set by the external provisioner on the CSIStorageCapacity objects it manages
+	csiStorageCapacityDriverLabel = "csi.storage.k8s.io/drivername"
+	// topologyNodeKey is the topology key the CSI driver uses to identify a node
+	topologyNodeKey = "topology.hostpath.csi/node"
+
+	nearlyFullReasonThresholdExceeded = "ThresholdExceeded"
+	nearlyFullReasonBelowThreshold    = "BelowThreshold"
+	nearlyFullReasonCapacityUnknown   = "CapacityUnknown"
+	storagePoolNearlyFullEvent        = "StoragePoolNearlyFull"
+)
+
+// nodeCapacity is the capacity of a storage pool on a single node
+type nodeCapacity struct {
+	capacity    *resource.Quantity
+	allocatable *resource.Quantity
+	used        *resource.Quantity
+}
+
+// storagePoolCapacities maps the storage pool name to the capacity of the pool on each node
+type storagePoolCapacities map[string]map[string]nodeCapacity
+
+// reconcileStoragePoolCapacity sums the node capacities of each storage pool into the storage pool status, and sets
+// the NearlyFull condition of the pools. The conditions of the previous statuses are kept so the transition times only
+// change when the condition status changes.
+func (r *ReconcileHostPathProvisioner) reconcileStoragePoolCapacity(cr *hostpathprovisionerv1.HostPathProvisioner, capacities storagePoolCapacities, previousStatuses []hostpathprovisionerv1.StoragePoolStatus) {
+	previousConditions := make(map[string][]metav1.Condition)
+	for _, status := range previousStatuses {
+		previousConditions[status.Name] = status.Conditions
+	}
+	thresholds := make(map[string]int32)
+	for _, storagePool := range cr.Spec.StoragePools {
+		if storagePool.NearlyFullThreshold != nil {
+			thresholds[storagePool.Name] = *storagePool.NearlyFullThreshold
+		}
+	}
+
+	for i := range cr.Status.StoragePoolStatuses {
+		status := &cr.Status.StoragePoolStatuses[i]
+		status.Capacity, status.Allocatable, status.Used = sumNodeCapacities(capacities[status.Name])
+		status.Conditions = previousConditions[status.Name]
+		threshold, ok := thresholds[status.Name]
+		if !ok {
+			threshold = hostpathprovisionerv1.DefaultNearlyFullThreshold
+		}
+		wasNearlyFull := meta.IsStatusConditionTrue(status.Conditions, hostpathprovisionerv1.StoragePoolNearlyFull)
+		condition := nearlyFullCondition(capacities[status.Name], threshold)
+		meta.SetStatusCondition(&status.Conditions, condition)
+		if !wasNearlyFull && condition.Status == metav1.ConditionTrue {
+			r.recorder.Event(cr, corev1.EventTypeWarning, storagePoolNearlyFullEvent, fmt.Sprintf("Storage pool %s: %s", status.Name, condition.Message))
+		}
+	}
+}
+
+// getStoragePoolCapacities aggregates the CSIStorageCapacity objects published by the CSI driver into the capacity of
+// each storage pool on each node.
+func (r *ReconcileHostPathProvisioner) getStoragePoolCapacities(namespace string) (storagePoolCapacities, error) {
+	storageClassList := &storagev1.StorageClassList{}
+	if err := r.client.List(context.TODO(), storageClassList); err != nil {
+		return nil, err
+	}
+	// The capacity is published per storage class, find the storage pool of each storage class.
+	storagePoolByStorageClass := make(map[string]string)
+	for _, storageClass := range storageClassList.Items {
+		if storageClass.Provisioner != driverName {
+			continue
+		}
+		storagePool, ok := storageClass.Parameters[storagePoolParameter]
+		if !ok {
+			storagePool = legacyStoragePoolName
+		}
+		storagePoolByStorageClass[storageClass.GetName()] = storagePool
+	}
+
+	capacityList := &storagev1.CSIStorageCapacityList{}
+	if err := r.client.List(context.TODO(), capacityList, client.InNamespace(namespace), client.MatchingLabels{
+		csiStorageCapacityDriverLabel: driverName,
+	}); err != nil {
+		return nil, err
+	}
+	res := make(storagePoolCapacities)
+	for _, capacity := range capacityList.Items {
+		storagePool, ok := storagePoolByStorageClass[capacity.StorageClassName]
+		if !ok || capacity.NodeTopology == nil {
+			continue
+		}
+		node := capacity.NodeTopology.MatchLabels[topologyNodeKey]
+		if node == "" {
+			continue
+		}
+		if _, ok := res[storagePool]; !ok {
+			res[storagePool] = make(map[string]nodeCapacity)
+		}
+		// Multiple storage classes can use the same storage pool, they all report the same capacity.
+		if _, ok := res[storagePool][node]; ok {
+			continue
+		}
+		res[storagePool][node] = createNodeCapacity(&capacity)
+	}
+	return res, nil
+}
+
+// createNodeCapacity converts a CSIStorageCapacity into a node capacity. The driver publishes the available space as
+// the capacity and the size of the file system as the maximum volume size.
+func createNodeCapacity(capacity *storagev1.CSIStorageCapacity) nodeCapacity {
+	res := nodeCapacity{}
+	if capacity.Capacity != nil {
+		allocatable := capacity.Capacity.DeepCopy()
+		res.allocatable = &allocatable
+	}
+	if capacity.MaximumVolumeSize != nil {
+		total := capacity.MaximumVolumeSize.DeepCopy()
+		res.capacity = &total
+	}
+	if res.capacity != nil && res.allocatable != nil {
+		used := res.capacity.DeepCopy()
+		used.Sub(*res.allocatable)
+		if used.Sign() < 0 {
+			used = resource.MustParse("0")
+		}
+		res.used = &used
+	}
+	return res
+}
+
+// sumNodeCapacities returns the total capacity, allocatable and used bytes of the nodes, nil if no node reported them.
+func sumNodeCapacities(nodeCapacities map[string]nodeCapacity) (capacity, allocatable, used *resource.Quantity) {
+	add := func(total **resource.Quantity, value *resource.Quantity) {
+		if value == nil {
+			return
+		}
+		if *total == nil {
+			sum := value.DeepCopy()
+			*total = &sum
+			return
+		}
+		(*total).Add(*value)
+	}
+	for _, nodeCapacity := range nodeCapacities {
+		add(&capacity, nodeCapacity.capacity)
+		add(&allocatable, nodeCapacity.allocatable)
+		add(&used, nodeCapacity.used)
+	}
+	return capacity, allocatable, used
+}
+
+func nearlyFullCondition(nodeCapacities map[string]nodeCapacity, threshold int32) metav1.Condition {
+	known := false
+	fullNodes := make([]string, 0)
+	for node, nodeCapacity := range nodeCapacities {
+		if nodeCapacity.capacity == nil || nodeCapacity.used == nil || nodeCapacity.capacity.IsZero() {
+			continue
+		}
+		known = true
+		if nodeCapacity.used.AsApproximateFloat64()*100 >= float64(threshold)*nodeCapacity.capacity.AsApproximateFloat64() {
+			fullNodes = append(fullNodes, node)
+		}
+	}
+	sort.Strings(fullNodes)
+	condition := metav1.Condition{
+		Type: hostpathprovisionerv1.StoragePoolNearlyFull,
+	}
+	switch {
+	case !known:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = nearlyFullReasonCapacityUnknown
+		condition.Message = "The capacity of the storage pool has not been reported"
+	case len(fullNodes) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = nearlyFullReasonThresholdExceeded
+		condition.Message = fmt.Sprintf("%d%% or more of the capacity is used on nodes: %s", threshold, strings.Join(fullNodes, ", "))
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = nearlyFullReasonBelowThreshold
+		condition.Message = fmt.Sprintf("Less than %d%% of the capacity is used on all nodes", threshold)
+	}
+	return condition
+}
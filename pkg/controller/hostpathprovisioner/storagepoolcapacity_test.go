@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("storage pool capacity", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		createStorageClass := func(cl client.Client, name, provisioner, storagePool string) {
+			storageClass := &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Provisioner: provisioner,
+				Parameters: map[string]string{
+					storagePoolParameter: storagePool,
+				},
+			}
+			gomega.Expect(cl.Create(context.TODO(), storageClass)).To(gomega.Succeed())
+		}
+
+		createCapacity := func(cl client.Client, storageClassName, node, available, size string) {
+			capacity := &storagev1.CSIStorageCapacity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("csisc-%s-%s", storageClassName, node),
+					Namespace: testNamespace,
+					Labels: map[string]string{
+						csiStorageCapacityDriverLabel: driverName,
+					},
+				},
+				StorageClassName: storageClassName,
+				NodeTopology: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						topologyNodeKey: node,
+					},
+				},
+				Capacity:          ptr.To(resource.MustParse(available)),
+				MaximumVolumeSize: ptr.To(resource.MustParse(size)),
+			}
+			gomega.Expect(cl.Create(context.TODO(), capacity)).To(gomega.Succeed())
+		}
+
+		drainEvents := func(recorder *record.FakeRecorder) []string {
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			return events
+		}
+
+		reconcileAndGetPoolStatus := func(r *ReconcileHostPathProvisioner, cl client.Client) hppv1.StoragePoolStatus {
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			cr := &hppv1.HostPathProvisioner{}
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.StoragePoolStatuses).To(gomega.HaveLen(1))
+			return cr.Status.StoragePoolStatuses[0]
+		}
+
+		ginkgo.It("Should report the capacity of the storage pool on all nodes", func() {
+			_, r, cl := createDeployedCr(createLegacyStoragePoolCr())
+			createStorageClass(cl, "legacy-sc", driverName, "legacy")
+			createStorageClass(cl, "legacy-sc-retain", driverName, "legacy")
+			createStorageClass(cl, "other-sc", "other.csi.driver", "legacy")
+			createCapacity(cl, "legacy-sc", "node2", "60Gi", "100Gi")
+			createCapacity(cl, "legacy-sc", "node1", "40Gi", "100Gi")
+			createCapacity(cl, "legacy-sc-retain", "node1", "40Gi", "100Gi")
+			createCapacity(cl, "other-sc", "node3", "1Gi", "100Gi")
+
+			status := reconcileAndGetPoolStatus(r, cl)
+			gomega.Expect(status.Capacity).To(gomega.Equal(ptr.To(resource.MustParse("200Gi"))))
+			gomega.Expect(status.Allocatable).To(gomega.Equal(ptr.To(resource.MustParse("100Gi"))))
+			gomega.Expect(status.Used).To(gomega.Equal(ptr.To(resource.MustParse("100Gi"))))
+			condition := meta.FindStatusCondition(status.Conditions, hppv1.StoragePoolNearlyFull)
+			gomega.Expect(condition).ToNot(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(condition.Reason).To(gomega.Equal(nearlyFullReasonBelowThreshold))
+		})
+
+		ginkgo.It("Should mark the storage pool nearly full when the threshold is crossed", func() {
+			cr := createLegacyStoragePoolCr()
+			cr.Spec.StoragePools[0].NearlyFullThreshold = ptr.To[int32](50)
+			_, r, cl := createDeployedCr(cr)
+			createStorageClass(cl, "legacy-sc", driverName, "legacy")
+			createCapacity(cl, "legacy-sc", "node1", "40Gi", "100Gi")
+			createCapacity(cl, "legacy-sc", "node2", "60Gi", "100Gi")
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+
+			status := reconcileAndGetPoolStatus(r, cl)
+			condition := meta.FindStatusCondition(status.Conditions, hppv1.StoragePoolNearlyFull)
+			gomega.Expect(condition).ToNot(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+			gomega.Expect(condition.Reason).To(gomega.Equal(nearlyFullReasonThresholdExceeded))
+			gomega.Expect(condition.Message).To(gomega.Equal("50% or more of the capacity is used on nodes: node1"))
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(storagePoolNearlyFullEvent)))
+
+			transitionTime := condition.LastTransitionTime
+			status = reconcileAndGetPoolStatus(r, cl)
+			condition = meta.FindStatusCondition(status.Conditions, hppv1.StoragePoolNearlyFull)
+			gomega.Expect(condition.LastTransitionTime).To(gomega.Equal(transitionTime))
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(storagePoolNearlyFullEvent)))
+		})
+
+		ginkgo.It("Should report unknown if no capacity is published", func() {
+			_, r, cl := createDeployedCr(createLegacyStoragePoolCr())
+			status := reconcileAndGetPoolStatus(r, cl)
+			gomega.Expect(status.Capacity).To(gomega.BeNil())
+			condition := meta.FindStatusCondition(status.Conditions, hppv1.StoragePoolNearlyFull)
+			gomega.Expect(condition).ToNot(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionUnknown))
+			gomega.Expect(condition.Reason).To(gomega.Equal(nearlyFullReasonCapacityUnknown))
+		})
+	})
+})
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+const (
+	expandingReasonInProgress   = "InProgress"
+	expandingReasonNotSupported = "NotSupported"
+	expandingReasonFailed       = "Failed"
+	expandingReasonComplete     = "Complete"
+	storagePoolExpansionEvent   = "StoragePoolExpansion"
+)
+
+// expandStoragePoolPVC updates the storage request of an existing pool PVC to the request of the PVC template, if the
+// template requests more storage and the storage class of the PVC allows expansion. PVCs cannot shrink, a smaller
+// template request is ignored.
+func (r *ReconcileHostPathProvisioner) expandStoragePoolPVC(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, storagePool *hostpathprovisionerv1.StoragePool, pvc *corev1.PersistentVolumeClaim) error {
+	desired, ok := storagePool.PVCTemplate.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return nil
+	}
+	current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if desired.Cmp(current) <= 0 {
+		return nil
+	}
+	expandable, err := r.isClaimExpandable(pvc)
+	if err != nil {
+		return err
+	}
+	if !expandable {
+		logger.V(3).Info("Storage class of storage pool pvc doesn't allow volume expansion", "storagepool.Name", storagePool.Name, "pvc.Name", pvc.GetName())
+		return nil
+	}
+	logger.Info("Expanding storage pool pvc", "storagepool.Name", storagePool.Name, "pvc.Name", pvc.GetName(), "from", current.String(), "to", desired.String())
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desired
+	if err := r.client.Update(context.TODO(), pvc); err != nil {
+		r.recorder.Event(cr, corev1.EventTypeWarning, updateResourceFailed, fmt.Sprintf(updateMessageFailed, pvc.GetName(), err))
+		return err
+	}
+	r.recorder.Event(cr, corev1.EventTypeNormal, storagePoolExpansionEvent, fmt.Sprintf("Expanding storage pool %s pvc %s from %s to %s", storagePool.Name, pvc.GetName(), current.String(), desired.String()))
+	return nil
+}
+
+// isClaimExpandable returns true if the storage class of the PVC allows volume expansion
+func (r *ReconcileHostPathProvisioner) isClaimExpandable(pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+	storageClass := &storagev1.StorageClass{}
+	if err := r.client.Get(context.TODO(), client.ObjectKey{Name: *pvc.Spec.StorageClassName}, storageClass); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
+
+// getClaimExpansionPhase returns the state of the expansion of a pool PVC to the size of the PVC template, empty if
+// the PVC has the size of the template.
+func (r *ReconcileHostPathProvisioner) getClaimExpansionPhase(storagePool *hostpathprovisionerv1.StoragePool, pvc *corev1.PersistentVolumeClaim) (hostpathprovisionerv1.StoragePoolExpansionPhase, error) {
+	switch pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage] {
+	case corev1.PersistentVolumeClaimControllerResizeInfeasible, corev1.PersistentVolumeClaimNodeResizeInfeasible:
+		return hostpathprovisionerv1.StoragePoolExpansionFailed, nil
+	}
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if desired, ok := storagePool.PVCTemplate.Resources.Requests[corev1.ResourceStorage]; ok && desired.Cmp(requested) > 0 {
+		expandable, err := r.isClaimExpandable(pvc)
+		if err != nil {
+			return "", err
+		}
+		if !expandable {
+			return hostpathprovisionerv1.StoragePoolExpansionNotSupported, nil
+		}
+		return hostpathprovisionerv1.StoragePoolExpansionInProgress, nil
+	}
+	// The capacity is only known once the PVC is bound, it is updated when the storage provider finished resizing.
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok && requested.Cmp(capacity) > 0 {
+		return hostpathprovisionerv1.StoragePoolExpansionInProgress, nil
+	}
+	return "", nil
+}
+
+// reconcileStoragePoolExpansion sets the Expanding condition of the storage pools with a PVC template, based on the
+// expansion state of the pool PVCs.
+func (r *ReconcileHostPathProvisioner) reconcileStoragePoolExpansion(cr *hostpathprovisionerv1.HostPathProvisioner, namespace string) error {
+	if cr.Spec.PathConfig != nil {
+		return nil
+	}
+	statuses := make(map[string]*hostpathprovisionerv1.StoragePoolStatus)
+	for i := range cr.Status.StoragePoolStatuses {
+		statuses[cr.Status.StoragePoolStatuses[i].Name] = &cr.Status.StoragePoolStatuses[i]
+	}
+	for _, storagePool := range cr.Spec.StoragePools {
+		status, ok := statuses[storagePool.Name]
+		if !ok || storagePool.PVCTemplate == nil {
+			continue
+		}
+		claims, err := r.getClaimsByStoragePool(&storagePool, namespace)
+		if err != nil {
+			return err
+		}
+		claimsByPhase := make(map[hostpathprovisionerv1.StoragePoolExpansionPhase][]string)
+		for _, claim := range claims {
+			phase, err := r.getClaimExpansionPhase(&storagePool, &claim)
+			if err != nil {
+				return err
+			}
+			claimsByPhase[phase] = append(claimsByPhase[phase], claim.GetName())
+		}
+		meta.SetStatusCondition(&status.Conditions, expandingCondition(claimsByPhase))
+	}
+	return nil
+}
+
+func expandingCondition(claimsByPhase map[hostpathprovisionerv1.StoragePoolExpansionPhase][]string) metav1.Condition {
+	claimList := func(phase hostpathprovisionerv1.StoragePoolExpansionPhase) string {
+		claims := claimsByPhase[phase]
+		sort.Strings(claims)
+		return strings.Join(claims, ", ")
+	}
+	condition := metav1.Condition{
+		Type: hostpathprovisionerv1.StoragePoolExpanding,
+	}
+	switch {
+	case len(claimsByPhase[hostpathprovisionerv1.StoragePoolExpansionInProgress]) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = expandingReasonInProgress
+		condition.Message = fmt.Sprintf("Expanding pool PVCs: %s", claimList(hostpathprovisionerv1.StoragePoolExpansionInProgress))
+	case len(claimsByPhase[hostpathprovisionerv1.StoragePoolExpansionFailed]) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = expandingReasonFailed
+		condition.Message = fmt.Sprintf("Failed to expand pool PVCs: %s", claimList(hostpathprovisionerv1.StoragePoolExpansionFailed))
+	case len(claimsByPhase[hostpathprovisionerv1.StoragePoolExpansionNotSupported]) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = expandingReasonNotSupported
+		condition.Message = fmt.Sprintf("The storage class doesn't allow expansion of pool PVCs: %s", claimList(hostpathprovisionerv1.StoragePoolExpansionNotSupported))
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = expandingReasonComplete
+		condition.Message = "All pool PVCs have the size of the PVC template"
+	}
+	return condition
+}
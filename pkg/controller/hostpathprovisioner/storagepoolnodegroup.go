@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+// storagePoolNodeGroup is a group of nodes that match the node selectors of the same storage pools, but not of all
+// of them. The CSI driver only gets the storage pools it may use on a node, so the nodes of a group run the pods of
+// their own CSI DaemonSet.
+type storagePoolNodeGroup struct {
+	// storagePools are the names of the storage pools with a node selector that the nodes match
+	storagePools []string
+	// nodeNames are the sorted names of the nodes of the group
+	nodeNames []string
+	// nodeLabels are the labels of one of the nodes, they all match the same storage pools
+	nodeLabels map[string]string
+}
+
+// daemonSetName returns the name of the CSI DaemonSet of the group, it only depends on the storage pools of the group.
+func (g *storagePoolNodeGroup) daemonSetName() string {
+	return getResourceNameWithMaxLength(fmt.Sprintf("%s-csi", MultiPurposeHostPathProvisionerName), fmt.Sprintf("pools-%s", hash(strings.Join(g.storagePools, ","))), maxNameLength)
+}
+
+// hasStoragePoolNodeSelectors returns true if a storage pool of the CR is restricted to some nodes
+func hasStoragePoolNodeSelectors(cr *hostpathprovisionerv1.HostPathProvisioner) bool {
+	for _, storagePool := range cr.Spec.StoragePools {
+		if len(storagePool.NodeSelector) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getStoragePoolNodeRequirements returns the node label requirements of the storage pools with a node selector, the
+// main CSI DaemonSet only runs on the nodes matching all of them.
+func getStoragePoolNodeRequirements(cr *hostpathprovisionerv1.HostPathProvisioner) []corev1.NodeSelectorRequirement {
+	res := make([]corev1.NodeSelectorRequirement, 0)
+	seen := sets.New[string]()
+	for _, storagePool := range cr.Spec.StoragePools {
+		keys := make([]string, 0, len(storagePool.NodeSelector))
+		for key := range storagePool.NodeSelector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := storagePool.NodeSelector[key]
+			if seen.Has(key + "=" + value) {
+				continue
+			}
+			seen.Insert(key + "=" + value)
+			res = append(res, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{value},
+			})
+		}
+	}
+	return res
+}
+
+// getStoragePoolNodeGroups groups the nodes that don't match all storage pools by the storage pools they match. The
+// nodes with their own log verbosity are left out, they already run their own CSI DaemonSet, and so are the nodes that
+// match no storage pool at all.
+func (r *ReconcileHostPathProvisioner) getStoragePoolNodeGroups(cr *hostpathprovisionerv1.HostPathProvisioner) ([]*storagePoolNodeGroup, error) {
+	if !hasStoragePoolNodeSelectors(cr) {
+		return nil, nil
+	}
+	nodeList := &corev1.NodeList{}
+	if err := r.client.List(context.TODO(), nodeList); err != nil {
+		return nil, err
+	}
+	logVerbosityNodes := sets.New(getLogVerbosityNodes(cr)...)
+	groups := make(map[string]*storagePoolNodeGroup)
+	for _, node := range nodeList.Items {
+		if logVerbosityNodes.Has(node.GetName()) {
+			continue
+		}
+		matching := make([]string, 0)
+		matchesAll, matchesAny := true, false
+		for _, storagePool := range cr.Spec.StoragePools {
+			if !storagePoolMatchesLabels(&storagePool, node.GetLabels()) {
+				matchesAll = false
+				continue
+			}
+			matchesAny = true
+			if len(storagePool.NodeSelector) > 0 {
+				matching = append(matching, storagePool.Name)
+			}
+		}
+		if matchesAll || !matchesAny {
+			continue
+		}
+		key := strings.Join(matching, ",")
+		group, ok := groups[key]
+		if !ok {
+			group = &storagePoolNodeGroup{
+				storagePools: matching,
+				nodeLabels:   node.GetLabels(),
+			}
+			groups[key] = group
+		}
+		group.nodeNames = append(group.nodeNames, node.GetName())
+	}
+	res := make([]*storagePoolNodeGroup, 0, len(groups))
+	for _, group := range groups {
+		sort.Strings(group.nodeNames)
+		res = append(res, group)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return strings.Join(res[i].storagePools, ",") < strings.Join(res[j].storagePools, ",")
+	})
+	return res, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+)
+
+const (
+	storagePoolReasonClaimNotFound   = "ClaimNotFound"
+	storagePoolReasonClaimPending    = "ClaimPending"
+	storagePoolReasonClaimLost       = "ClaimLost"
+	storagePoolReasonMounterNotReady = "MounterNotReady"
+	storagePoolReasonMounterFailed   = "MounterFailed"
+	storagePoolFailedEvent           = "StoragePoolFailed"
+
+	// storagePoolNotReadyRequeueInterval is how often storage pools that are not ready are checked again. The pool PVCs,
+	// mounter Deployments and nodes are watched, but the mounter pods are not, and a mounter that starts crash looping
+	// doesn't always change the status of its Deployment.
+	storagePoolNotReadyRequeueInterval = time.Minute
+)
+
+// mounterFailedWaitingReasons are the reasons a mounter container waits that need intervention, instead of
+// resolving by themselves like ContainerCreating.
+var mounterFailedWaitingReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// storagePoolPhaseOrder orders the phases from the worst to the best, the phase of a storage pool is the worst phase
+// of the pool on its nodes.
+var storagePoolPhaseOrder = []hostpathprovisionerv1.StoragePoolPhase{
+	hostpathprovisionerv1.StoragePoolFailed,
+	hostpathprovisionerv1.StoragePoolPreparing,
+	hostpathprovisionerv1.StoragePoolMounting,
+	hostpathprovisionerv1.StoragePoolReady,
+}
+
+// isMounterPodFailed returns true if the mounter pod cannot mount the storage pool without intervention, because it
+// keeps crashing or its image cannot be pulled.
+func isMounterPodFailed(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodFailed {
+		return true
+	}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if waiting := containerStatus.State.Waiting; waiting != nil && mounterFailedWaitingReasons[waiting.Reason] {
+			return true
+		}
+		if terminated := containerStatus.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// storagePoolPhase returns the phase of a storage pool from its state on each node, with the reason and message of
+// the first node that is in that phase. A storage pool without nodes is ready.
+func storagePoolPhase(nodeStatuses map[string]hostpathprovisionerv1.NodeStoragePoolStatus) (hostpathprovisionerv1.StoragePoolPhase, string, string) {
+	nodeNames := make([]string, 0, len(nodeStatuses))
+	for nodeName := range nodeStatuses {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+	for _, phase := range storagePoolPhaseOrder {
+		if phase == hostpathprovisionerv1.StoragePoolReady {
+			break
+		}
+		nodesInPhase := make([]string, 0)
+		for _, nodeName := range nodeNames {
+			if nodeStatuses[nodeName].Phase == phase {
+				nodesInPhase = append(nodesInPhase, nodeName)
+			}
+		}
+		if len(nodesInPhase) == 0 {
+			continue
+		}
+		first := nodeStatuses[nodesInPhase[0]]
+		message := fmt.Sprintf("Storage pool is %s on %d of %d nodes, node %s: %s", phase, len(nodesInPhase), len(nodeNames), nodesInPhase[0], first.LastError)
+		return phase, first.Reason, message
+	}
+	return hostpathprovisionerv1.StoragePoolReady, "", ""
+}
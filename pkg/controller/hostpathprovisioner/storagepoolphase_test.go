@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("storage pool phase", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		drainEvents := func(recorder *record.FakeRecorder) []string {
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			return events
+		}
+
+		getPoolStatus := func(cl client.Client) hppv1.StoragePoolStatus {
+			cr := &hppv1.HostPathProvisioner{}
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(cr.Status.StoragePoolStatuses).To(gomega.HaveLen(1))
+			return cr.Status.StoragePoolStatuses[0]
+		}
+
+		setClaimPhase := func(cl client.Client, name string, phase corev1.PersistentVolumeClaimPhase) {
+			pvc := &corev1.PersistentVolumeClaim{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: testNamespace}, pvc)).To(gomega.Succeed())
+			pvc.Status.Phase = phase
+			gomega.Expect(cl.Status().Update(context.TODO(), pvc)).To(gomega.Succeed())
+		}
+
+		setMountersReady := func(cl client.Client, nodeNames ...string) {
+			for _, nodeName := range nodeNames {
+				deployment := &appsv1.Deployment{}
+				gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: getStoragePoolDeploymentName("local", nodeName), Namespace: testNamespace}, deployment)).To(gomega.Succeed())
+				deployment.Status.ReadyReplicas = 1
+				gomega.Expect(cl.Status().Update(context.TODO(), deployment)).To(gomega.Succeed())
+			}
+		}
+
+		ginkgo.It("Should report the worst phase of the pool on its nodes", func() {
+			phase, reason, message := storagePoolPhase(map[string]hppv1.NodeStoragePoolStatus{
+				"node1": {Phase: hppv1.StoragePoolReady},
+				"node2": {Phase: hppv1.StoragePoolMounting, Reason: storagePoolReasonMounterNotReady, LastError: "Mounter pod not found"},
+				"node3": {Phase: hppv1.StoragePoolPreparing, Reason: storagePoolReasonClaimPending, LastError: "Pool PVC hpp-pool-local-node3 is Pending instead of Bound"},
+				"node4": {Phase: hppv1.StoragePoolPreparing, Reason: storagePoolReasonClaimNotFound, LastError: "Pool PVC hpp-pool-local-node4 not found"},
+			})
+			gomega.Expect(phase).To(gomega.Equal(hppv1.StoragePoolPreparing))
+			gomega.Expect(reason).To(gomega.Equal(storagePoolReasonClaimPending))
+			gomega.Expect(message).To(gomega.Equal("Storage pool is Preparing on 2 of 4 nodes, node node3: Pool PVC hpp-pool-local-node3 is Pending instead of Bound"))
+
+			phase, reason, message = storagePoolPhase(map[string]hppv1.NodeStoragePoolStatus{
+				"node1": {Phase: hppv1.StoragePoolReady},
+			})
+			gomega.Expect(phase).To(gomega.Equal(hppv1.StoragePoolReady))
+			gomega.Expect(reason).To(gomega.BeEmpty())
+			gomega.Expect(message).To(gomega.BeEmpty())
+
+			phase, _, _ = storagePoolPhase(nil)
+			gomega.Expect(phase).To(gomega.Equal(hppv1.StoragePoolReady))
+		})
+
+		ginkgo.DescribeTable("Should detect a failed mounter pod", func(status corev1.PodStatus, expected bool) {
+			gomega.Expect(isMounterPodFailed(&corev1.Pod{Status: status})).To(gomega.Equal(expected))
+		},
+			ginkgo.Entry("pending pod", corev1.PodStatus{Phase: corev1.PodPending}, false),
+			ginkgo.Entry("failed pod", corev1.PodStatus{Phase: corev1.PodFailed}, true),
+			ginkgo.Entry("creating container", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}}},
+			}, false),
+			ginkgo.Entry("crash looping container", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}},
+			}, true),
+			ginkgo.Entry("image pull back off", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}}},
+			}, true),
+			ginkgo.Entry("terminated with error", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}}},
+			}, true),
+			ginkgo.Entry("running container", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+			}, false),
+		)
+
+		ginkgo.It("Should move the storage pool through Preparing and Mounting to Ready", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+
+			poolStatus := getPoolStatus(cl)
+			gomega.Expect(poolStatus.Phase).To(gomega.Equal(hppv1.StoragePoolMounting))
+			gomega.Expect(poolStatus.Reason).To(gomega.Equal(storagePoolReasonMounterNotReady))
+			gomega.Expect(poolStatus.Message).To(gomega.Equal("Storage pool is Mounting on 2 of 2 nodes, node node1: Mounter pod not found"))
+
+			setMountersReady(cl, "node1", "node2")
+			res, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(res.RequeueAfter).To(gomega.BeZero())
+			poolStatus = getPoolStatus(cl)
+			gomega.Expect(poolStatus.Phase).To(gomega.Equal(hppv1.StoragePoolReady))
+			gomega.Expect(poolStatus.Reason).To(gomega.BeEmpty())
+			gomega.Expect(poolStatus.Message).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should fail a storage pool that lost its PVC without failing the CR", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			setMountersReady(cl, "node1", "node2")
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+
+			setClaimPhase(cl, getStoragePoolPVCName("local", "node2"), corev1.ClaimLost)
+			res, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(res.RequeueAfter).To(gomega.Equal(storagePoolNotReadyRequeueInterval))
+			poolStatus := getPoolStatus(cl)
+			gomega.Expect(poolStatus.Phase).To(gomega.Equal(hppv1.StoragePoolFailed))
+			gomega.Expect(poolStatus.Reason).To(gomega.Equal(storagePoolReasonClaimLost))
+			gomega.Expect(poolStatus.Message).To(gomega.Equal("Storage pool is Failed on 1 of 2 nodes, node node2: Pool PVC hpp-pool-local-node2 lost its volume"))
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(storagePoolFailedEvent)))
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(IsCrHealthy(cr)).To(gomega.BeTrue())
+
+			ginkgo.By("Reconciling again, it should not repeat the event")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(storagePoolFailedEvent)))
+
+			ginkgo.By("Binding the PVC again, it should recover")
+			setClaimPhase(cl, getStoragePoolPVCName("local", "node2"), corev1.ClaimBound)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(getPoolStatus(cl).Phase).To(gomega.Equal(hppv1.StoragePoolReady))
+		})
+	})
+})
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/monitoring/metrics"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+)
+
+const (
+	// strandedSinceAnnotation is set on a stranded persistent volume to the time the operator found it stranded
+	strandedSinceAnnotation = "hostpathprovisioner.kubevirt.io/stranded-since"
+	// defaultStrandedVolumeGracePeriod is how long a persistent volume is stranded before the stranded volume policy is
+	// applied, so the volumes of a node that is registered again are left alone.
+	defaultStrandedVolumeGracePeriod = 10 * time.Minute
+	// maxReportedStrandedVolumes is the number of stranded volumes listed in the condition message
+	maxReportedStrandedVolumes = 10
+	// provisionerFinalizer is set by the external provisioner on the volumes it provisioned, the provisioner of a node
+	// that no longer exists cannot remove it.
+	provisionerFinalizer = "external-provisioner.volume.kubernetes.io/finalizer"
+
+	strandedVolumeReleased = "StrandedVolumeReleased"
+	strandedVolumeDeleted  = "StrandedVolumeDeleted"
+)
+
+// strandedVolume is a persistent volume provisioned by the hostpath provisioner on a node that no longer exists
+type strandedVolume struct {
+	pv       *corev1.PersistentVolume
+	nodeName string
+}
+
+// getVolumeNodeName returns the node the persistent volume is pinned to by its required node affinity, or an empty
+// string if it is not pinned to a single node.
+func getVolumeNodeName(pv *corev1.PersistentVolume) string {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return ""
+	}
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, expression := range term.MatchExpressions {
+			if (expression.Key == topologyNodeKey || expression.Key == corev1.LabelHostname) && expression.Operator == corev1.NodeSelectorOpIn && len(expression.Values) == 1 {
+				return expression.Values[0]
+			}
+		}
+	}
+	return ""
+}
+
+// getStrandedVolumes returns the persistent volumes of the hostpath provisioner pinned to nodes that don't exist, and
+// the volumes marked stranded whose node exists again.
+func (r *ReconcileHostPathProvisioner) getStrandedVolumes() ([]strandedVolume, []*corev1.PersistentVolume, error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.client.List(context.TODO(), nodeList); err != nil {
+		return nil, nil, err
+	}
+	if len(nodeList.Items) == 0 {
+		// Without nodes there is no telling which nodes are gone for good
+		return nil, nil, nil
+	}
+	// Legacy volumes are pinned by the hostname label, which is not always the node name
+	nodeNames := sets.New[string]()
+	for _, node := range nodeList.Items {
+		nodeNames.Insert(node.GetName())
+		if hostname, ok := node.GetLabels()[corev1.LabelHostname]; ok {
+			nodeNames.Insert(hostname)
+		}
+	}
+	pvList := &corev1.PersistentVolumeList{}
+	if err := r.client.List(context.TODO(), pvList); err != nil {
+		return nil, nil, err
+	}
+	stranded := make([]strandedVolume, 0)
+	recovered := make([]*corev1.PersistentVolume, 0)
+	for i := range pvList.Items {
+		pv := &pvList.Items[i]
+		if !util.IsHostPathProvisionerVolume(pv) {
+			continue
+		}
+		nodeName := getVolumeNodeName(pv)
+		if nodeName == "" {
+			continue
+		}
+		if !nodeNames.Has(nodeName) {
+			stranded = append(stranded, strandedVolume{pv: pv, nodeName: nodeName})
+		} else if _, ok := pv.GetAnnotations()[strandedSinceAnnotation]; ok {
+			recovered = append(recovered, pv)
+		}
+	}
+	sort.Slice(stranded, func(i, j int) bool {
+		return stranded[i].pv.GetName() < stranded[j].pv.GetName()
+	})
+	return stranded, recovered, nil
+}
+
+// reconcileStrandedVolumes reports the persistent volumes pinned to nodes that no longer exist, and applies the stranded
+// volume policy to the volumes stranded for longer than the grace period. It returns when the volumes still in their
+// grace period should be checked again.
+func (r *ReconcileHostPathProvisioner) reconcileStrandedVolumes(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner) (time.Duration, error) {
+	stranded, recovered, err := r.getStrandedVolumes()
+	if err != nil {
+		return 0, err
+	}
+	for _, pv := range recovered {
+		// The node is back, a new grace period starts if it goes away again
+		logger.Info("Node of stranded persistent volume exists again", "PersistentVolume", pv.GetName())
+		delete(pv.Annotations, strandedSinceAnnotation)
+		if err := r.client.Update(context.TODO(), pv); err != nil {
+			return 0, err
+		}
+	}
+	metrics.SetStrandedVolumesGaugeValue(len(stranded))
+	r.reportStrandedVolumes(cr, stranded)
+
+	policy := getStrandedVolumePolicy(cr)
+	if policy == hostpathprovisionerv1.StrandedVolumePolicyReport {
+		return 0, nil
+	}
+	gracePeriod := getStrandedVolumeGracePeriod(cr)
+	requeueAfter := time.Duration(0)
+	for _, volume := range stranded {
+		remaining, err := r.getRemainingStrandedVolumeGracePeriod(volume.pv, gracePeriod)
+		if err != nil {
+			return 0, err
+		}
+		if remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+		if err := r.applyStrandedVolumePolicy(logger, cr, policy, volume); err != nil {
+			return 0, err
+		}
+	}
+	return requeueAfter, nil
+}
+
+// reportStrandedVolumes sets the StrandedVolumes condition of the CR, and emits an event when the stranded volumes
+// change. The condition is only added once a volume is stranded, and cleared when there are no stranded volumes left.
+func (r *ReconcileHostPathProvisioner) reportStrandedVolumes(cr *hostpathprovisionerv1.HostPathProvisioner, stranded []strandedVolume) {
+	previous := conditions.FindStatusCondition(cr.Status.Conditions, ConditionStrandedVolumes)
+	if len(stranded) == 0 {
+		if previous != nil {
+			MarkCrNoStrandedVolumes(cr)
+		}
+		return
+	}
+	volumes := make([]string, 0, maxReportedStrandedVolumes)
+	for i, volume := range stranded {
+		if i == maxReportedStrandedVolumes {
+			volumes = append(volumes, fmt.Sprintf("and %d more", len(stranded)-maxReportedStrandedVolumes))
+			break
+		}
+		volumes = append(volumes, fmt.Sprintf("%s on node %s", volume.pv.GetName(), volume.nodeName))
+	}
+	message := fmt.Sprintf(strandedVolumesMessage, len(stranded), strings.Join(volumes, ", "))
+	if previous == nil || previous.Status != corev1.ConditionTrue || previous.Message != message {
+		r.recorder.Event(cr, corev1.EventTypeWarning, strandedVolumesFound, message)
+	}
+	MarkCrStrandedVolumes(cr, message)
+}
+
+// getRemainingStrandedVolumeGracePeriod returns how much of the grace period of the stranded persistent volume is left.
+// The grace period starts when the volume is first found stranded.
+func (r *ReconcileHostPathProvisioner) getRemainingStrandedVolumeGracePeriod(pv *corev1.PersistentVolume, gracePeriod time.Duration) (time.Duration, error) {
+	since, err := time.Parse(time.RFC3339, pv.GetAnnotations()[strandedSinceAnnotation])
+	if err != nil {
+		since = time.Now()
+		if pv.Annotations == nil {
+			pv.Annotations = make(map[string]string)
+		}
+		pv.Annotations[strandedSinceAnnotation] = since.UTC().Format(time.RFC3339)
+		if err := r.client.Update(context.TODO(), pv); err != nil {
+			return 0, err
+		}
+	}
+	return gracePeriod - time.Since(since), nil
+}
+
+// applyStrandedVolumePolicy deletes the claim of the stranded persistent volume, so the workload can create a new claim
+// on a node that exists. Release retains the volume, Delete deletes it as well.
+func (r *ReconcileHostPathProvisioner) applyStrandedVolumePolicy(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, policy hostpathprovisionerv1.StrandedVolumePolicy, volume strandedVolume) error {
+	pv := volume.pv
+	if policy == hostpathprovisionerv1.StrandedVolumePolicyRelease && pv.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+		// Keep the volume once it is released, its data is still there if the node comes back
+		pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+		if err := r.client.Update(context.TODO(), pv); err != nil {
+			return err
+		}
+	}
+	claimDeleted, err := r.deleteStrandedVolumeClaim(logger, pv)
+	if err != nil {
+		return err
+	}
+	if policy == hostpathprovisionerv1.StrandedVolumePolicyRelease {
+		if claimDeleted {
+			r.recorder.Event(cr, corev1.EventTypeNormal, strandedVolumeReleased, fmt.Sprintf("Released persistent volume %s pinned to node %s that no longer exists, deleted claim %s/%s", pv.GetName(), volume.nodeName, pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name))
+		}
+		return nil
+	}
+	if HasFinalizer(pv, provisionerFinalizer) {
+		RemoveFinalizer(pv, provisionerFinalizer)
+		if err := r.client.Update(context.TODO(), pv); err != nil {
+			return err
+		}
+	}
+	if pv.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	logger.Info("Deleting stranded persistent volume", "PersistentVolume", pv.GetName(), "node", volume.nodeName)
+	if err := r.client.Delete(context.TODO(), pv); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	r.recorder.Event(cr, corev1.EventTypeNormal, strandedVolumeDeleted, fmt.Sprintf("Deleted persistent volume %s pinned to node %s that no longer exists", pv.GetName(), volume.nodeName))
+	return nil
+}
+
+// deleteStrandedVolumeClaim deletes the claim bound to the stranded persistent volume, and returns true if it was
+// deleted. A claim with the same name that is not bound to the volume is left alone. The claim is in the namespace of
+// its workload, which the cache of the operator doesn't cover, so it is read from the API server.
+func (r *ReconcileHostPathProvisioner) deleteStrandedVolumeClaim(logger logr.Logger, pv *corev1.PersistentVolume) (bool, error) {
+	claimRef := pv.Spec.ClaimRef
+	if claimRef == nil {
+		return false, nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.apiReader.Get(context.TODO(), client.ObjectKey{Name: claimRef.Name, Namespace: claimRef.Namespace}, pvc); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if pvc.Spec.VolumeName != pv.GetName() || (claimRef.UID != "" && claimRef.UID != pvc.GetUID()) || pvc.GetDeletionTimestamp() != nil {
+		return false, nil
+	}
+	logger.Info("Deleting claim of stranded persistent volume", "PersistentVolume", pv.GetName(), "PersistentVolumeClaim", client.ObjectKeyFromObject(pvc))
+	if err := r.client.Delete(context.TODO(), pvc); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("stranded volumes", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		drainEvents := func(recorder *record.FakeRecorder) []string {
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			return events
+		}
+
+		nodeAffinity := func(key, nodeName string) *corev1.VolumeNodeAffinity {
+			return &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: key, Operator: corev1.NodeSelectorOpIn, Values: []string{nodeName}},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		// createBoundVolume creates a hostpath persistent volume on the node, bound to a claim with the same name
+		createBoundVolume := func(cl client.Client, name, nodeName string) {
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+					UID:       types.UID(name + "-uid"),
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					VolumeName: name,
+				},
+			}
+			gomega.Expect(cl.Create(context.TODO(), pvc)).To(gomega.Succeed())
+			pv := &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Finalizers: []string{provisionerFinalizer},
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{
+							Driver:       util.CSIDriverName,
+							VolumeHandle: name,
+						},
+					},
+					PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+					ClaimRef: &corev1.ObjectReference{
+						Name:      name,
+						Namespace: "default",
+						UID:       pvc.GetUID(),
+					},
+					NodeAffinity: nodeAffinity(topologyNodeKey, nodeName),
+				},
+			}
+			gomega.Expect(cl.Create(context.TODO(), pv)).To(gomega.Succeed())
+		}
+
+		getVolume := func(cl client.Client, name string) (*corev1.PersistentVolume, error) {
+			pv := &corev1.PersistentVolume{}
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: name}, pv)
+			return pv, err
+		}
+
+		getClaim := func(cl client.Client, name string) error {
+			return cl.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: "default"}, &corev1.PersistentVolumeClaim{})
+		}
+
+		// expireGracePeriod marks the volume stranded for longer than the grace period
+		expireGracePeriod := func(cl client.Client, name string) {
+			pv, err := getVolume(cl, name)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			pv.SetAnnotations(map[string]string{strandedSinceAnnotation: time.Now().Add(-defaultStrandedVolumeGracePeriod - time.Minute).UTC().Format(time.RFC3339)})
+			gomega.Expect(cl.Update(context.TODO(), pv)).To(gomega.Succeed())
+		}
+
+		deployWithPolicy := func(policy hppv1.StrandedVolumePolicy) (*hppv1.HostPathProvisioner, *ReconcileHostPathProvisioner, client.Client) {
+			cr := createStoragePoolWithTemplateCr()
+			cr.Spec.StrandedVolumePolicy = ptr.To(policy)
+			cr, r, cl := createDeployedCr(cr)
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			return cr, r, cl
+		}
+
+		ginkgo.DescribeTable("Should find the node of a persistent volume", func(affinity *corev1.VolumeNodeAffinity, expected string) {
+			gomega.Expect(getVolumeNodeName(&corev1.PersistentVolume{Spec: corev1.PersistentVolumeSpec{NodeAffinity: affinity}})).To(gomega.Equal(expected))
+		},
+			ginkgo.Entry("csi volume", nodeAffinity(topologyNodeKey, "node1"), "node1"),
+			ginkgo.Entry("legacy volume", nodeAffinity(corev1.LabelHostname, "node1"), "node1"),
+			ginkgo.Entry("other topology", nodeAffinity("topology.kubernetes.io/zone", "zone1"), ""),
+			ginkgo.Entry("no affinity", nil, ""),
+			ginkgo.Entry("several nodes", &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: topologyNodeKey, Operator: corev1.NodeSelectorOpIn, Values: []string{"node1", "node2"}},
+							},
+						},
+					},
+				},
+			}, ""),
+		)
+
+		ginkgo.It("Should report stranded volumes without touching them by default", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			createBoundVolume(cl, "stranded", "node3")
+			createBoundVolume(cl, "healthy", "node1")
+			other := &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "other",
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					NodeAffinity: nodeAffinity(corev1.LabelHostname, "node3"),
+				},
+			}
+			gomega.Expect(cl.Create(context.TODO(), other)).To(gomega.Succeed())
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			condition := conditions.FindStatusCondition(cr.Status.Conditions, ConditionStrandedVolumes)
+			gomega.Expect(condition).ToNot(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(corev1.ConditionTrue))
+			gomega.Expect(condition.Message).To(gomega.Equal("1 persistent volumes are pinned to nodes that no longer exist, stranded on node node3"))
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(strandedVolumesFound)))
+			pv, err := getVolume(cl, "stranded")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(pv.GetAnnotations()).ToNot(gomega.HaveKey(strandedSinceAnnotation))
+			gomega.Expect(getClaim(cl, "stranded")).To(gomega.Succeed())
+
+			ginkgo.By("Reconciling again, it should not repeat the event")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(strandedVolumesFound)))
+
+			ginkgo.By("Deleting the volume, it should clear the condition")
+			pv.SetFinalizers(nil)
+			gomega.Expect(cl.Update(context.TODO(), pv)).To(gomega.Succeed())
+			gomega.Expect(cl.Delete(context.TODO(), pv)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.IsStatusConditionFalse(cr.Status.Conditions, ConditionStrandedVolumes)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should not add the condition without stranded volumes", func() {
+			cr, r, cl := createDeployedCr(createStoragePoolWithTemplateCr())
+			scaleClusterNodesAndDsUp(1, 2, cr, r, cl)
+			createBoundVolume(cl, "healthy", "node1")
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.FindStatusCondition(cr.Status.Conditions, ConditionStrandedVolumes)).To(gomega.BeNil())
+		})
+
+		ginkgo.It("Should wait for the grace period before applying the policy", func() {
+			cr, r, cl := deployWithPolicy(hppv1.StrandedVolumePolicyDelete)
+			createBoundVolume(cl, "stranded", "node3")
+
+			requeueAfter, err := r.reconcileStrandedVolumes(logf.Log, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(requeueAfter).To(gomega.BeNumerically(">", defaultStrandedVolumeGracePeriod-time.Minute))
+			gomega.Expect(requeueAfter).To(gomega.BeNumerically("<=", defaultStrandedVolumeGracePeriod))
+			pv, err := getVolume(cl, "stranded")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(pv.GetAnnotations()).To(gomega.HaveKey(strandedSinceAnnotation))
+			gomega.Expect(getClaim(cl, "stranded")).To(gomega.Succeed())
+
+			ginkgo.By("Adding the node back, it should restart the grace period")
+			addNodesToCluster(3, 3, cl)
+			requeueAfter, err = r.reconcileStrandedVolumes(logf.Log, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(requeueAfter).To(gomega.BeZero())
+			pv, err = getVolume(cl, "stranded")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(pv.GetAnnotations()).ToNot(gomega.HaveKey(strandedSinceAnnotation))
+		})
+
+		ginkgo.It("Should release stranded volumes with the Release policy", func() {
+			cr, r, cl := deployWithPolicy(hppv1.StrandedVolumePolicyRelease)
+			createBoundVolume(cl, "stranded", "node3")
+			expireGracePeriod(cl, "stranded")
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(errors.IsNotFound(getClaim(cl, "stranded"))).To(gomega.BeTrue())
+			pv, err := getVolume(cl, "stranded")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(pv.Spec.PersistentVolumeReclaimPolicy).To(gomega.Equal(corev1.PersistentVolumeReclaimRetain))
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(strandedVolumeReleased)))
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.IsStatusConditionTrue(cr.Status.Conditions, ConditionStrandedVolumes)).To(gomega.BeTrue())
+
+			ginkgo.By("Reconciling again, it should not release the volume again")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(strandedVolumeReleased)))
+		})
+
+		ginkgo.It("Should delete stranded volumes with the Delete policy", func() {
+			cr, r, cl := deployWithPolicy(hppv1.StrandedVolumePolicyDelete)
+			createBoundVolume(cl, "stranded", "node3")
+			expireGracePeriod(cl, "stranded")
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(errors.IsNotFound(getClaim(cl, "stranded"))).To(gomega.BeTrue())
+			_, err = getVolume(cl, "stranded")
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(strandedVolumeDeleted)))
+
+			ginkgo.By("Reconciling again, it should clear the condition")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.IsStatusConditionFalse(cr.Status.Conditions, ConditionStrandedVolumes)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should not delete a claim that is not bound to the stranded volume", func() {
+			_, r, cl := deployWithPolicy(hppv1.StrandedVolumePolicyRelease)
+			createBoundVolume(cl, "stranded", "node3")
+			expireGracePeriod(cl, "stranded")
+			pvc := &corev1.PersistentVolumeClaim{}
+			gomega.Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "stranded", Namespace: "default"}, pvc)).To(gomega.Succeed())
+			pvc.Spec.VolumeName = "replacement"
+			gomega.Expect(cl.Update(context.TODO(), pvc)).To(gomega.Succeed())
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(getClaim(cl, "stranded")).To(gomega.Succeed())
+		})
+
+		ginkgo.It("Should delete the claims of stranded volumes outside the cached namespace", func() {
+			_, r, cl := deployWithPolicy(hppv1.StrandedVolumePolicyRelease)
+			createBoundVolume(cl, "stranded", "node3")
+			expireGracePeriod(cl, "stranded")
+			r.client = namespacedCacheClient{Client: cl, namespace: testNamespace}
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(errors.IsNotFound(getClaim(cl, "stranded"))).To(gomega.BeTrue())
+		})
+
+		ginkgo.DescribeTable("Should apply the policy with the verbs of the operator role", func(policy hppv1.StrandedVolumePolicy) {
+			_, r, cl := deployWithPolicy(policy)
+			createBoundVolume(cl, "stranded", "node3")
+			requests := recordRequests(r, cl)
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			expireGracePeriod(cl, "stranded")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(errors.IsNotFound(getClaim(cl, "stranded"))).To(gomega.BeTrue())
+
+			ginkgo.By("Adding the node back, it should clear the stranded annotation of the volumes that are left")
+			addNodesToCluster(3, 3, cl)
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			verbs := make([]string, 0)
+			for _, request := range *requests {
+				if request.resource == "persistentvolumes" && request.name == "stranded" {
+					verbs = append(verbs, request.verb)
+				}
+			}
+			gomega.Expect(verbs).To(gomega.ContainElement("update"))
+			expectAllowedByOperatorRole(*requests)
+		},
+			ginkgo.Entry("release", hppv1.StrandedVolumePolicyRelease),
+			ginkgo.Entry("delete", hppv1.StrandedVolumePolicyDelete),
+		)
+
+		ginkgo.It("Should use the grace period of the CR", func() {
+			cr, r, cl := deployWithPolicy(hppv1.StrandedVolumePolicyDelete)
+			cr.Spec.StrandedVolumeGracePeriod = &metav1.Duration{Duration: time.Hour}
+			createBoundVolume(cl, "stranded", "node3")
+			expireGracePeriod(cl, "stranded")
+
+			requeueAfter, err := r.reconcileStrandedVolumes(logf.Log, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(requeueAfter).To(gomega.BeNumerically(">", time.Hour-defaultStrandedVolumeGracePeriod-2*time.Minute))
+			gomega.Expect(requeueAfter).To(gomega.BeNumerically("<=", time.Hour-defaultStrandedVolumeGracePeriod-time.Minute))
+			gomega.Expect(getClaim(cl, "stranded")).To(gomega.Succeed())
+			_, err = getVolume(cl, "stranded")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+	})
+})
+
+// namespacedCacheClient reads objects like the client of the manager, whose cache only covers the namespace of the
+// operator.
+type namespacedCacheClient struct {
+	client.Client
+	namespace string
+}
+
+func (c namespacedCacheClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if key.Namespace != "" && key.Namespace != c.namespace {
+		return fmt.Errorf("unable to get: %s because of unknown namespace for the cache", key)
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func (c namespacedCacheClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if listOpts.Namespace != "" && listOpts.Namespace != c.namespace {
+		return fmt.Errorf("unable to list: %s because of unknown namespace for the cache", listOpts.Namespace)
+	}
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	cached := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		if accessor, err := meta.Accessor(item); err != nil || accessor.GetNamespace() == "" || accessor.GetNamespace() == c.namespace {
+			cached = append(cached, item)
+		}
+	}
+	return meta.SetList(list, cached)
+}
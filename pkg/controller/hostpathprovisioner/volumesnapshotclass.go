@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostpathprovisioner
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hostpathprovisionerv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/pkg/util"
+)
+
+const (
+	volumeSnapshotClassBaseName = "hostpath-csi-snapclass"
+	snapshotDeletionPolicy      = "Delete"
+	// managedVolumeSnapshotClassLabelKey marks the volume snapshot classes created by the operator, classes without it
+	// belong to the user and are never updated or deleted.
+	managedVolumeSnapshotClassLabelKey = "hostpathprovisioner.kubevirt.io/managed-volume-snapshot-class"
+)
+
+var (
+	volumeSnapshotClassGVK = schema.GroupVersionKind{
+		Group:   "snapshot.storage.k8s.io",
+		Version: "v1",
+		Kind:    "VolumeSnapshotClass",
+	}
+	volumeSnapshotClassListGVK = volumeSnapshotClassGVK.GroupVersion().WithKind("VolumeSnapshotClassList")
+)
+
+// reconcileVolumeSnapshotClasses creates a VolumeSnapshotClass for each storage pool that has a snapshot provider if the
+// snapshot feature gate is enabled. Classes that are no longer needed are removed.
+func (r *ReconcileHostPathProvisioner) reconcileVolumeSnapshotClasses(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner) (reconcile.Result, error) {
+	desiredClasses := make([]*unstructured.Unstructured, 0)
+	if r.isFeatureGateEnabled(snapshotFeatureGate, cr) {
+		for _, storagePool := range cr.Spec.StoragePools {
+			if storagePool.SnapshotProvider != nil {
+				desiredClasses = append(desiredClasses, createVolumeSnapshotClassObject(storagePool.Name))
+			}
+		}
+	}
+	used, err := r.checkVolumeSnapshotClassUsed()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !used {
+		if len(desiredClasses) > 0 {
+			// The external snapshotter CRDs are not installed, nothing can take snapshots.
+			logger.Info("VolumeSnapshotClass CRD not found, not creating VolumeSnapshotClasses")
+		}
+		r.reportVolumeSnapshotClassConflicts(cr, nil)
+		return reconcile.Result{}, nil
+	}
+	current, err := r.currentVolumeSnapshotClasses()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	conflicts := make([]string, 0)
+	for _, desired := range desiredClasses {
+		delete(current, desired.GetName())
+		managed, err := r.reconcileVolumeSnapshotClass(logger, cr, desired)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !managed {
+			conflicts = append(conflicts, desired.GetName())
+		}
+	}
+	r.reportVolumeSnapshotClassConflicts(cr, conflicts)
+	// Remove the snapshot classes of pools that no longer support snapshots, or all of them if the gate is off.
+	for _, snapshotClass := range current {
+		logger.Info("Deleting unused VolumeSnapshotClass", "VolumeSnapshotClass.Name", snapshotClass.GetName())
+		if err := r.client.Delete(context.TODO(), &snapshotClass); err != nil && !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// reconcileVolumeSnapshotClass creates or updates the volume snapshot class, and returns false if a volume snapshot class
+// with the same name exists that is not managed by the operator.
+func (r *ReconcileHostPathProvisioner) reconcileVolumeSnapshotClass(logger logr.Logger, cr *hostpathprovisionerv1.HostPathProvisioner, desired *unstructured.Unstructured) (bool, error) {
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(volumeSnapshotClassGVK)
+	err := r.client.Get(context.TODO(), client.ObjectKeyFromObject(desired), found)
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("Creating a new VolumeSnapshotClass", "VolumeSnapshotClass.Name", desired.GetName())
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			r.recorder.Event(cr, corev1.EventTypeWarning, createResourceFailed, fmt.Sprintf(createMessageFailed, desired.GetName(), err))
+			return true, err
+		}
+		r.recorder.Event(cr, corev1.EventTypeNormal, createResourceSuccess, fmt.Sprintf(createMessageSucceeded, desired, desired.GetName()))
+		return true, nil
+	} else if err != nil {
+		return true, err
+	}
+
+	if !isManagedVolumeSnapshotClass(found) {
+		// Leave the volume snapshot class of the user alone, the conflict is reported in the condition of the CR.
+		logger.Info("VolumeSnapshotClass is not managed by the hostpath provisioner, not updating it", "VolumeSnapshotClass.Name", found.GetName())
+		return false, nil
+	}
+
+	// Keep a copy of the original for comparison later.
+	currentRuntimeObjCopy := found.DeepCopy()
+
+	// allow users to add new annotations (but not change ours)
+	mergeLabelsAndAnnotations(desired, found)
+	for _, field := range []string{"driver", "deletionPolicy", "parameters"} {
+		found.Object[field] = desired.Object[field]
+	}
+
+	if !reflect.DeepEqual(currentRuntimeObjCopy, found) {
+		logJSONDiff(logger, currentRuntimeObjCopy, found)
+		logger.Info("Updating VolumeSnapshotClass", "VolumeSnapshotClass.Name", desired.GetName())
+		if err := r.client.Update(context.TODO(), found); err != nil {
+			r.recorder.Event(cr, corev1.EventTypeWarning, updateResourceFailed, fmt.Sprintf(updateMessageFailed, desired.GetName(), err))
+			return true, err
+		}
+		r.recorder.Event(cr, corev1.EventTypeNormal, updateResourceSuccess, fmt.Sprintf(updateMessageSucceeded, desired, desired.GetName()))
+	}
+	return true, nil
+}
+
+// reportVolumeSnapshotClassConflicts sets the VolumeSnapshotClassConflict condition of the CR, and emits an event when
+// the conflicting volume snapshot classes change. The condition is only added once there is a conflict, and cleared
+// when there are no conflicts left.
+func (r *ReconcileHostPathProvisioner) reportVolumeSnapshotClassConflicts(cr *hostpathprovisionerv1.HostPathProvisioner, conflicts []string) {
+	previous := conditions.FindStatusCondition(cr.Status.Conditions, ConditionVolumeSnapshotClassConflict)
+	if len(conflicts) == 0 {
+		if previous != nil {
+			MarkCrNoVolumeSnapshotClassConflict(cr)
+		}
+		return
+	}
+	message := fmt.Sprintf(volumeSnapshotClassConflictMessage, strings.Join(conflicts, ", "))
+	if previous == nil || previous.Status != corev1.ConditionTrue || previous.Message != message {
+		r.recorder.Event(cr, corev1.EventTypeWarning, volumeSnapshotClassConflict, message)
+	}
+	MarkCrVolumeSnapshotClassConflict(cr, message)
+}
+
+func isManagedVolumeSnapshotClass(snapshotClass *unstructured.Unstructured) bool {
+	return snapshotClass.GetLabels()[managedVolumeSnapshotClassLabelKey] == "true"
+}
+
+func (r *ReconcileHostPathProvisioner) checkVolumeSnapshotClassUsed() (bool, error) {
+	// Check if the snapshot CRDs are installed, if not return false.
+	listObj := &unstructured.UnstructuredList{}
+	listObj.SetGroupVersionKind(volumeSnapshotClassListGVK)
+	if err := r.client.List(context.TODO(), listObj, client.Limit(1)); err != nil {
+		if meta.IsNoMatchError(err) || strings.Contains(err.Error(), "failed to find API group") {
+			// external snapshotter not deployed
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *ReconcileHostPathProvisioner) currentVolumeSnapshotClasses() (map[string]unstructured.Unstructured, error) {
+	res := make(map[string]unstructured.Unstructured)
+	listObj := &unstructured.UnstructuredList{}
+	listObj.SetGroupVersionKind(volumeSnapshotClassListGVK)
+	if err := r.client.List(context.TODO(), listObj, client.MatchingLabels{
+		"k8s-app":                          MultiPurposeHostPathProvisionerName,
+		managedVolumeSnapshotClassLabelKey: "true",
+	}); err != nil {
+		return res, err
+	}
+	for _, snapshotClass := range listObj.Items {
+		res[snapshotClass.GetName()] = snapshotClass
+	}
+	return res, nil
+}
+
+func (r *ReconcileHostPathProvisioner) deleteVolumeSnapshotClasses() error {
+	if used, err := r.checkVolumeSnapshotClassUsed(); !used || err != nil {
+		return err
+	}
+	current, err := r.currentVolumeSnapshotClasses()
+	if err != nil {
+		return err
+	}
+	for _, snapshotClass := range current {
+		if err := r.client.Delete(context.TODO(), &snapshotClass); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func getVolumeSnapshotClassName(storagePoolName string) string {
+	return getResourceNameWithMaxLength(volumeSnapshotClassBaseName, storagePoolName, validation.DNS1123SubdomainMaxLength)
+}
+
+func createVolumeSnapshotClassObject(storagePoolName string) *unstructured.Unstructured {
+	snapshotClass := &unstructured.Unstructured{}
+	snapshotClass.SetGroupVersionKind(volumeSnapshotClassGVK)
+	snapshotClass.SetName(getVolumeSnapshotClassName(storagePoolName))
+	labels := util.GetRecommendedLabels()
+	labels[managedVolumeSnapshotClassLabelKey] = "true"
+	snapshotClass.SetLabels(labels)
+	snapshotClass.Object["driver"] = driverName
+	snapshotClass.Object["deletionPolicy"] = snapshotDeletionPolicy
+	snapshotClass.Object["parameters"] = map[string]interface{}{
+		storagePoolParameter: storagePoolName,
+	}
+	return snapshotClass
+}
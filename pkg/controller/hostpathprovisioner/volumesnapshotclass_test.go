@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hostpathprovisioner
+
+import (
+	"context"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	conditions "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hppv1 "kubevirt.io/hostpath-provisioner-operator/pkg/apis/hostpathprovisioner/v1beta1"
+	"kubevirt.io/hostpath-provisioner-operator/version"
+)
+
+var _ = ginkgo.Describe("Controller reconcile loop", func() {
+	ginkgo.Context("volume snapshot classes", func() {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      "test-name",
+				Namespace: testNamespace,
+			},
+		}
+
+		ginkgo.BeforeEach(func() {
+			watchNamespaceFunc = func() string {
+				return testNamespace
+			}
+			version.VersionStringFunc = func() (string, error) {
+				return versionString, nil
+			}
+		})
+
+		enableSnapshotFeatureGate := func(r *ReconcileHostPathProvisioner, cl client.Client) {
+			cr := &hppv1.HostPathProvisioner{}
+			err := cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			cr.Spec.FeatureGates = []string{snapshotFeatureGate}
+			err = cl.Update(context.TODO(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		}
+
+		createDeployedSnapshotCr := func() (*ReconcileHostPathProvisioner, client.Client) {
+			cr := createStoragePoolWithRWXTemplate()
+			cr.Spec.StoragePools = append(cr.Spec.StoragePools, hppv1.StoragePool{
+				Name: "no-snapshots",
+				Path: "/tmp/no-snapshots",
+			})
+			_, r, cl := createDeployedCr(cr)
+			enableSnapshotFeatureGate(r, cl)
+			return r, cl
+		}
+
+		drainEvents := func(recorder *record.FakeRecorder) []string {
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			return events
+		}
+
+		getVolumeSnapshotClass := func(cl client.Client, name string) (*unstructured.Unstructured, error) {
+			snapshotClass := &unstructured.Unstructured{}
+			snapshotClass.SetGroupVersionKind(volumeSnapshotClassGVK)
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: name}, snapshotClass)
+			return snapshotClass, err
+		}
+
+		listVolumeSnapshotClasses := func(cl client.Client) []unstructured.Unstructured {
+			listObj := &unstructured.UnstructuredList{}
+			listObj.SetGroupVersionKind(volumeSnapshotClassListGVK)
+			err := cl.List(context.TODO(), listObj)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			return listObj.Items
+		}
+
+		ginkgo.It("Should create a volume snapshot class for each storage pool with a snapshot provider", func() {
+			_, cl := createDeployedSnapshotCr()
+			gomega.Expect(listVolumeSnapshotClasses(cl)).To(gomega.HaveLen(1))
+			snapshotClass, err := getVolumeSnapshotClass(cl, getVolumeSnapshotClassName("testOverlayCSI"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(snapshotClass.GetName()).To(gomega.Equal("hostpath-csi-snapclass-testOverlayCSI"))
+			gomega.Expect(snapshotClass.Object["driver"]).To(gomega.Equal(driverName))
+			gomega.Expect(snapshotClass.Object["deletionPolicy"]).To(gomega.Equal("Delete"))
+			gomega.Expect(snapshotClass.Object["parameters"]).To(gomega.Equal(map[string]interface{}{"storagePool": "testOverlayCSI"}))
+			gomega.Expect(snapshotClass.GetLabels()[AppKubernetesPartOfLabel]).To(gomega.Equal("testing"))
+		})
+
+		ginkgo.It("Should restore a modified volume snapshot class", func() {
+			r, cl := createDeployedSnapshotCr()
+			snapshotClass, err := getVolumeSnapshotClass(cl, getVolumeSnapshotClassName("testOverlayCSI"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			snapshotClass.Object["deletionPolicy"] = "Retain"
+			snapshotClass.SetAnnotations(map[string]string{"user": "annotation"})
+			err = cl.Update(context.TODO(), snapshotClass)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			snapshotClass, err = getVolumeSnapshotClass(cl, getVolumeSnapshotClassName("testOverlayCSI"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(snapshotClass.Object["deletionPolicy"]).To(gomega.Equal("Delete"))
+			gomega.Expect(snapshotClass.GetAnnotations()["user"]).To(gomega.Equal("annotation"))
+		})
+
+		ginkgo.It("Should remove the volume snapshot classes when the feature gate is turned off", func() {
+			r, cl := createDeployedSnapshotCr()
+			gomega.Expect(listVolumeSnapshotClasses(cl)).To(gomega.HaveLen(1))
+			cr := &hppv1.HostPathProvisioner{}
+			err := cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			cr.Spec.FeatureGates = nil
+			err = cl.Update(context.TODO(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(listVolumeSnapshotClasses(cl)).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("Should report a volume snapshot class that is not managed by the operator", func() {
+			_, r, cl := createDeployedCr(createStoragePoolWithRWXTemplate())
+			snapshotClass := createVolumeSnapshotClassObject("testOverlayCSI")
+			snapshotClass.SetLabels(nil)
+			snapshotClass.Object["deletionPolicy"] = "Retain"
+			err := cl.Create(context.TODO(), snapshotClass)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			recorder := r.recorder.(*record.FakeRecorder)
+			drainEvents(recorder)
+			enableSnapshotFeatureGate(r, cl)
+
+			cr := &hppv1.HostPathProvisioner{}
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			condition := conditions.FindStatusCondition(cr.Status.Conditions, ConditionVolumeSnapshotClassConflict)
+			gomega.Expect(condition).ToNot(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(corev1.ConditionTrue))
+			gomega.Expect(condition.Message).To(gomega.Equal("VolumeSnapshotClasses already exist and are not managed by the hostpath provisioner: hostpath-csi-snapclass-testOverlayCSI"))
+			gomega.Expect(drainEvents(recorder)).To(gomega.ContainElement(gomega.ContainSubstring(volumeSnapshotClassConflict)))
+			snapshotClass, err = getVolumeSnapshotClass(cl, getVolumeSnapshotClassName("testOverlayCSI"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(snapshotClass.Object["deletionPolicy"]).To(gomega.Equal("Retain"))
+
+			ginkgo.By("Reconciling again, it should not repeat the event")
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(drainEvents(recorder)).ToNot(gomega.ContainElement(gomega.ContainSubstring(volumeSnapshotClassConflict)))
+
+			ginkgo.By("Deleting the volume snapshot class, it should create its own and clear the condition")
+			gomega.Expect(cl.Delete(context.TODO(), snapshotClass)).To(gomega.Succeed())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(cl.Get(context.TODO(), req.NamespacedName, cr)).To(gomega.Succeed())
+			gomega.Expect(conditions.IsStatusConditionFalse(cr.Status.Conditions, ConditionVolumeSnapshotClassConflict)).To(gomega.BeTrue())
+			snapshotClass, err = getVolumeSnapshotClass(cl, getVolumeSnapshotClassName("testOverlayCSI"))
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(snapshotClass.GetLabels()[managedVolumeSnapshotClassLabelKey]).To(gomega.Equal("true"))
+		})
+
+		ginkgo.It("Should not delete volume snapshot classes the user labeled like the operator", func() {
+			r, cl := createDeployedSnapshotCr()
+			snapshotClass := createVolumeSnapshotClassObject("user")
+			snapshotClass.SetName("user-snapclass")
+			labels := snapshotClass.GetLabels()
+			delete(labels, managedVolumeSnapshotClassLabelKey)
+			snapshotClass.SetLabels(labels)
+			gomega.Expect(cl.Create(context.TODO(), snapshotClass)).To(gomega.Succeed())
+
+			_, err := r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = getVolumeSnapshotClass(cl, "user-snapclass")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(listVolumeSnapshotClasses(cl)).To(gomega.HaveLen(2))
+		})
+
+		ginkgo.It("Should not fail if the volume snapshot class CRD is missing", func() {
+			_, r, cl := createDeployedCr(createStoragePoolWithRWXTemplate())
+			r.client = interceptor.NewClient(cl.(erroringFakeCtrlRuntimeClient).Client.(client.WithWatch), interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if list.GetObjectKind().GroupVersionKind() == volumeSnapshotClassListGVK {
+						return &meta.NoKindMatchError{GroupKind: volumeSnapshotClassGVK.GroupKind(), SearchedVersions: []string{"v1"}}
+					}
+					return c.List(ctx, list, opts...)
+				},
+			})
+			enableSnapshotFeatureGate(r, cl)
+			gomega.Expect(listVolumeSnapshotClasses(cl)).To(gomega.BeEmpty())
+
+			cr := &hppv1.HostPathProvisioner{}
+			err := cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			err = cl.Delete(context.TODO(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should delete the volume snapshot classes when the CR is deleted", func() {
+			r, cl := createDeployedSnapshotCr()
+			cr := &hppv1.HostPathProvisioner{}
+			err := cl.Get(context.TODO(), req.NamespacedName, cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			err = cl.Delete(context.TODO(), cr)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = r.Reconcile(context.TODO(), req)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			_, err = getVolumeSnapshotClass(cl, getVolumeSnapshotClassName("testOverlayCSI"))
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+		})
+	})
+})
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	mounterMetrics = []operatormetrics.Metric{
+		mounterRemountsCounter,
+	}
+
+	mounterRemountsCounter = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hpp_mounter_remounts_total",
+			Help: "The number of times the storage pool mounter re-established a lost mount of the storage pool on the host",
+		},
+	)
+)
+
+// SetupMounterMetrics registers the metrics of the storage pool mounter in the default prometheus registry
+func SetupMounterMetrics() error {
+	operatormetrics.Register = prometheus.DefaultRegisterer.Register
+
+	return operatormetrics.RegisterMetrics(
+		mounterMetrics,
+	)
+}
+
+// IncMounterRemounts increments the number of re-established storage pool mounts
+func IncMounterRemounts() {
+	mounterRemountsCounter.Inc()
+}
+
+// GetMounterRemounts returns the number of re-established storage pool mounts
+func GetMounterRemounts() (float64, error) {
+	metric := &dto.Metric{}
+	if err := mounterRemountsCounter.Write(metric); err != nil {
+		return 0, err
+	}
+	return metric.GetCounter().GetValue(), nil
+}
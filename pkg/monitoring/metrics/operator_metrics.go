@@ -23,6 +23,7 @@ import (
 var (
 	operatorMetrics = []operatormetrics.Metric{
 		readyGauge,
+		strandedVolumesGauge,
 	}
 
 	readyGauge = operatormetrics.NewGauge(
@@ -31,9 +32,21 @@ var (
 			Help: "HPP CR Ready",
 		},
 	)
+
+	strandedVolumesGauge = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hpp_stranded_persistent_volumes",
+			Help: "The number of persistent volumes provisioned by the hostpath provisioner that are pinned to nodes that no longer exist",
+		},
+	)
 )
 
 // SetReadyGaugeValue sets the ReadyGauge metric to a desired value
 func SetReadyGaugeValue(value int) {
 	readyGauge.Set(float64(value))
 }
+
+// SetStrandedVolumesGaugeValue sets the number of stranded persistent volumes
+func SetStrandedVolumesGaugeValue(value int) {
+	strandedVolumesGauge.Set(float64(value))
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The hostpath provisioner operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CSIDriverName is the name of the hostpath provisioner CSI driver
+	CSIDriverName = "kubevirt.io.hostpath-provisioner"
+	// LegacyProvisionerName is the name of the legacy hostpath provisioner
+	LegacyProvisionerName = "kubevirt.io/hostpath-provisioner"
+	// ProvisionedByAnnotation is the annotation the external provisioner sets on the persistent volumes it creates
+	ProvisionedByAnnotation = "pv.kubernetes.io/provisioned-by"
+)
+
+// IsHostPathProvisionerVolume returns true if the persistent volume was provisioned by the CSI driver or the legacy
+// provisioner
+func IsHostPathProvisionerVolume(pv *corev1.PersistentVolume) bool {
+	if pv.Spec.CSI != nil && pv.Spec.CSI.Driver == CSIDriverName {
+		return true
+	}
+	return pv.GetAnnotations()[ProvisionedByAnnotation] == LegacyProvisionerName
+}
+
+// CountHostPathProvisionerVolumes returns the number of persistent volumes provisioned by the hostpath provisioner
+func CountHostPathProvisionerVolumes(ctx context.Context, c client.Reader) (int, error) {
+	pvList := &corev1.PersistentVolumeList{}
+	if err := c.List(ctx, pvList); err != nil {
+		return 0, err
+	}
+	count := 0
+	for i := range pvList.Items {
+		if IsHostPathProvisionerVolume(&pvList.Items[i]) {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -61,12 +61,13 @@ func main() {
 		// Request more data.
 		return
 	})
+	crds := make([]*extv1.CustomResourceDefinition, 0)
 	for fileScanner.Scan() {
 		item := fileScanner.Bytes()
 		crdName, crd := getCRD(item)
 		if crd != nil && crdName != "" {
 			fmt.Printf("Generating CRD %s\n", crdName)
-			generateCrdGoFile(*outputdir, crd)
+			crds = append(crds, crd)
 		}
 		clusterRoleName, clusterRole := getClusterRoles(item)
 		if clusterRole != nil && clusterRoleName != "" && len(clusterRole.Rules) > 0 && clusterRole.Kind == "ClusterRole" {
@@ -84,10 +85,19 @@ func main() {
 			generateDeploymentGoFile(*outputdir, operatorDeployment)
 		}
 	}
+	if len(crds) > 0 {
+		generateCrdGoFile(*outputdir, crds)
+	}
+
+}
 
+// crdVariableNames maps the kind of each CRD to the name of the variable holding its yaml
+var crdVariableNames = map[string]string{
+	"HostPathProvisioner": "hppCRD",
+	"NodeStoragePool":     "nodeStoragePoolCRD",
 }
 
-func generateCrdGoFile(outputDir string, crd *extv1.CustomResourceDefinition) {
+func generateCrdGoFile(outputDir string, crds []*extv1.CustomResourceDefinition) {
 	filepath := filepath.Join(outputDir, "crd_generated.go")
 	os.Remove(filepath)
 	file, err := os.OpenFile(filepath, os.O_CREATE|os.O_WRONLY, 0644)
@@ -101,16 +111,22 @@ func generateCrdGoFile(outputDir string, crd *extv1.CustomResourceDefinition) {
 	}
 	fmt.Printf("output file: %s\n", file.Name())
 
-	file.WriteString("package helper\n\n")
-	file.WriteString("//hppCRD is a string yaml of the hpp CRD\n")
-	file.WriteString("var hppCRD string = \n`")
-
-	crd.Status = extv1.CustomResourceDefinitionStatus{}
-	b, _ := yaml.Marshal(crd)
-	// Remove all backticks from b
-	b = bytes.ReplaceAll(b, []byte("`"), []byte("'"))
-	file.WriteString(string(b))
-	file.WriteString("`\n")
+	file.WriteString("package helper\n")
+	for _, crd := range crds {
+		variableName, ok := crdVariableNames[crd.Spec.Names.Kind]
+		if !ok {
+			panic(fmt.Errorf("no variable name for CRD %s", crd.GetName()))
+		}
+		file.WriteString(fmt.Sprintf("\n//%s is a string yaml of the %s CRD\n", variableName, crd.Spec.Names.Kind))
+		file.WriteString(fmt.Sprintf("var %s string = \n`", variableName))
+
+		crd.Status = extv1.CustomResourceDefinitionStatus{}
+		b, _ := yaml.Marshal(crd)
+		// Remove all backticks from b
+		b = bytes.ReplaceAll(b, []byte("`"), []byte("'"))
+		file.WriteString(string(b))
+		file.WriteString("`\n")
+	}
 
 }
 
@@ -91,6 +91,9 @@ func main() {
 		if err := util.MarshallObject(helper.CreateCRDDef(), os.Stdout); err != nil {
 			panic(err)
 		}
+		if err := util.MarshallObject(helper.CreateNodeStoragePoolCRDDef(), os.Stdout); err != nil {
+			panic(err)
+		}
 
 	}
 
@@ -144,7 +147,11 @@ Hostpath provisioner is a local storage provisioner that uses kubernetes hostpat
 
 	sideEffectNone := admissionregistrationv1.SideEffectClassNone
 	webhookPath := "/validate-hostpathprovisioner-kubevirt-io-v1beta1-hostpathprovisioner"
+	mutatingWebhookPath := "/mutate-hostpathprovisioner-kubevirt-io-v1beta1-hostpathprovisioner"
+	conversionWebhookPath := "/convert"
 	failPolicy := admissionregistrationv1.Fail
+	// The webhooks only serve v1beta1, the api server converts the requests for the other versions
+	matchPolicy := admissionregistrationv1.Equivalent
 
 	csvVersion, err := semver.New(data.CsvVersion)
 	if err != nil {
@@ -166,7 +173,7 @@ Hostpath provisioner is a local storage provisioner that uses kubernetes hostpat
 				"alm-examples": `
       [
         {
-			"apiVersion": "hostpathprovisioner.kubevirt.io/v1beta1",
+			"apiVersion": "hostpathprovisioner.kubevirt.io/v1",
 			"kind": "HostPathProvisioner",
 			"metadata": {
 				"name": "hostpath-provisioner"
@@ -214,6 +221,7 @@ Hostpath provisioner is a local storage provisioner that uses kubernetes hostpat
 					DeploymentName: deployment.Name,
 					GenerateName:   "validate-hostpath-provisioner.kubevirt.io",
 					FailurePolicy:  &failPolicy,
+					MatchPolicy:    &matchPolicy,
 					Type:           csvv1.ValidatingAdmissionWebhook,
 					SideEffects:    &sideEffectNone,
 					WebhookPath:    &webhookPath,
@@ -238,6 +246,58 @@ Hostpath provisioner is a local storage provisioner that uses kubernetes hostpat
 						},
 					},
 				},
+				{
+					AdmissionReviewVersions: []string{
+						"v1beta1",
+					},
+					ContainerPort: int32(9443),
+					TargetPort: &intstr.IntOrString{
+						IntVal: int32(9443),
+					},
+					DeploymentName: deployment.Name,
+					GenerateName:   "mutate-hostpath-provisioner.kubevirt.io",
+					FailurePolicy:  &failPolicy,
+					MatchPolicy:    &matchPolicy,
+					Type:           csvv1.MutatingAdmissionWebhook,
+					SideEffects:    &sideEffectNone,
+					WebhookPath:    &mutatingWebhookPath,
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Operations: []admissionregistrationv1.OperationType{
+								admissionregistrationv1.Create,
+								admissionregistrationv1.Update,
+							},
+							Rule: admissionregistrationv1.Rule{
+								APIGroups: []string{
+									"hostpathprovisioner.kubevirt.io",
+								},
+								APIVersions: []string{
+									"v1beta1",
+								},
+								Resources: []string{
+									"hostpathprovisioners",
+								},
+							},
+						},
+					},
+				},
+				{
+					AdmissionReviewVersions: []string{
+						"v1",
+					},
+					ContainerPort: int32(9443),
+					TargetPort: &intstr.IntOrString{
+						IntVal: int32(9443),
+					},
+					DeploymentName: deployment.Name,
+					GenerateName:   "convert-hostpath-provisioner.kubevirt.io",
+					Type:           csvv1.ConversionWebhook,
+					SideEffects:    &sideEffectNone,
+					WebhookPath:    &conversionWebhookPath,
+					ConversionCRDs: []string{
+						"hostpathprovisioners.hostpathprovisioner.kubevirt.io",
+					},
+				},
 			},
 			DisplayName: "Hostpath Provisioner",
 			Description: description,
@@ -322,6 +382,13 @@ Hostpath provisioner is a local storage provisioner that uses kubernetes hostpat
 			CustomResourceDefinitions: csvv1.CustomResourceDefinitions{
 
 				Owned: []csvv1.CRDDescription{
+					{
+						Name:        "hostpathprovisioners.hostpathprovisioner.kubevirt.io",
+						Version:     "v1",
+						Kind:        "HostPathProvisioner",
+						DisplayName: "HostPathProvisioner Deployment",
+						Description: "Represents the deployment of HostPathProvisioner",
+					},
 					{
 						Name:        "hostpathprovisioners.hostpathprovisioner.kubevirt.io",
 						Version:     "v1beta1",
@@ -329,6 +396,20 @@ Hostpath provisioner is a local storage provisioner that uses kubernetes hostpat
 						DisplayName: "HostPathProvisioner Deployment",
 						Description: "Represents the deployment of HostPathProvisioner",
 					},
+					{
+						Name:        "nodestoragepools.hostpathprovisioner.kubevirt.io",
+						Version:     "v1",
+						Kind:        "NodeStoragePool",
+						DisplayName: "HostPathProvisioner Node Storage Pool",
+						Description: "Represents the state of a storage pool on a node",
+					},
+					{
+						Name:        "nodestoragepools.hostpathprovisioner.kubevirt.io",
+						Version:     "v1beta1",
+						Kind:        "NodeStoragePool",
+						DisplayName: "HostPathProvisioner Node Storage Pool",
+						Description: "Represents the state of a storage pool on a node",
+					},
 				},
 			},
 		},
@@ -17,6 +17,7 @@ rules:
   - watch
   - create
   - delete
+  - update
   - patch
 - apiGroups:
   - ""
@@ -28,6 +29,7 @@ rules:
   - watch
   - create
   - update
+  - delete
 - apiGroups:
   - ""
   resources:
@@ -185,9 +187,12 @@ rules:
   resources:
   - volumesnapshotclasses
   verbs:
+  - create
   - get
   - list
   - watch
+  - update
+  - delete
 - apiGroups:
   - snapshot.storage.k8s.io
   resources:
@@ -1,13 +1,26 @@
 package helper
 
-// hppCRD is a string yaml of the hpp CRD
-var hppCRD string = `apiVersion: apiextensions.k8s.io/v1
+//hppCRD is a string yaml of the HostPathProvisioner CRD
+var hppCRD string = 
+`apiVersion: apiextensions.k8s.io/v1
 kind: CustomResourceDefinition
 metadata:
   annotations:
+    cert-manager.io/inject-ca-from: hostpath-provisioner/hostpath-provisioner-operator-webhook-service-cert
     controller-gen.kubebuilder.io/version: v0.20.0
   name: hostpathprovisioners.hostpathprovisioner.kubevirt.io
 spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          name: hostpath-provisioner-operator-webhook-service
+          namespace: hostpath-provisioner
+          path: /convert
+          port: 443
+      conversionReviewVersions:
+      - v1
   group: hostpathprovisioner.kubevirt.io
   names:
     kind: HostPathProvisioner
@@ -16,7 +29,7 @@ spec:
     singular: hostpathprovisioner
   scope: Cluster
   versions:
-  - name: v1beta1
+  - name: v1
     schema:
       openAPIV3Schema:
         description: HostPathProvisioner is the Schema for the hostpathprovisioners
@@ -52,29 +65,713 @@ spec:
                 description: ImagePullPolicy is the container pull policy for the
                   host path provisioner containers
                 type: string
-              pathConfig:
-                description: PathConfig describes the location and layout of PV storage
-                  on nodes. Deprecated
+              imagePullSecrets:
+                description: ImagePullSecrets are the secrets used to pull the images
+                  of the pods managed by the operator
+                items:
+                  description: |-
+                    LocalObjectReference contains enough information to let you locate the
+                    referenced object inside the same namespace.
+                  properties:
+                    name:
+                      default: ""
+                      description: |-
+                        Name of the referent.
+                        This field is effectively required, but due to backwards compatibility is
+                        allowed to be empty. Instances of this type with an empty value here are
+                        almost certainly wrong.
+                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                      type: string
+                  type: object
+                  x-kubernetes-map-type: atomic
+                type: array
+                x-kubernetes-list-type: atomic
+              images:
+                description: |-
+                  Images override the images of the components, components without an image use the image configured in the
+                  operator
                 properties:
-                  path:
-                    description: Path The path the directories for the PVs are created
-                      under
+                  csiProvisioner:
+                    description: CSIProvisioner is the image of the csi-provisioner
+                      container
                     type: string
-                  useNamingPrefix:
-                    description: UseNamingPrefix Use the name of the PVC requesting
-                      the PV as part of the directory created
-                    type: boolean
+                  csiSnapshotter:
+                    description: CSISnapshotter is the image of the csi-snapshotter
+                      container
+                    type: string
+                  legacyProvisioner:
+                    description: LegacyProvisioner is the image of the legacy hostpath
+                      provisioner container
+                    type: string
+                  livenessProbe:
+                    description: LivenessProbe is the image of the liveness-probe
+                      container
+                    type: string
+                  mounter:
+                    description: Mounter is the image of the storage pool mounter
+                      and cleanup containers
+                    type: string
+                  nodeDriverRegistrar:
+                    description: NodeDriverRegistrar is the image of the node-driver-registrar
+                      container
+                    type: string
+                  provisioner:
+                    description: Provisioner is the image of the hostpath provisioner
+                      CSI driver container
+                    type: string
+                type: object
+              logVerbosity:
+                description: LogVerbosity is the log verbosity of the operator and
+                  the components
+                properties:
+                  components:
+                    description: Components override the verbosity of each component
+                    properties:
+                      csiProvisioner:
+                        description: CSIProvisioner is the verbosity of the csi-provisioner
+                          container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      csiSnapshotter:
+                        description: CSISnapshotter is the verbosity of the csi-snapshotter
+                          container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      mounter:
+                        description: |-
+                          Mounter is the verbosity of the storage pool mounter and cleanup containers, they log at the info level if no
+                          verbosity is set in the CR
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      nodeDriverRegistrar:
+                        description: NodeDriverRegistrar is the verbosity of the node-driver-registrar
+                          container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      provisioner:
+                        description: Provisioner is the verbosity of the hostpath
+                          provisioner CSI driver container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                    type: object
+                  default:
+                    description: |-
+                      Default is the verbosity of all the components, defaults to the VERBOSITY environment variable of the operator
+                      or 3 if not set
+                    format: int32
+                    minimum: 0
+                    type: integer
+                  nodes:
+                    description: |-
+                      Nodes override the verbosity of the components on specific nodes, the CSI driver on those nodes runs in a
+                      separate DaemonSet
+                    items:
+                      description: NodeLogVerbosity defines the log verbosity of the
+                        components on a node
+                      properties:
+                        components:
+                          description: Components override the verbosity of each component
+                            on the node
+                          properties:
+                            csiProvisioner:
+                              description: CSIProvisioner is the verbosity of the
+                                csi-provisioner container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            csiSnapshotter:
+                              description: CSISnapshotter is the verbosity of the
+                                csi-snapshotter container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            mounter:
+                              description: |-
+                                Mounter is the verbosity of the storage pool mounter and cleanup containers, they log at the info level if no
+                                verbosity is set in the CR
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            nodeDriverRegistrar:
+                              description: NodeDriverRegistrar is the verbosity of
+                                the node-driver-registrar container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            provisioner:
+                              description: Provisioner is the verbosity of the hostpath
+                                provisioner CSI driver container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                          type: object
+                        default:
+                          description: Default is the verbosity of all the components
+                            on the node
+                          format: int32
+                          minimum: 0
+                          type: integer
+                        nodeName:
+                          description: NodeName is the name of the node
+                          type: string
+                      required:
+                      - nodeName
+                      type: object
+                    type: array
+                    x-kubernetes-list-map-keys:
+                    - nodeName
+                    x-kubernetes-list-type: map
+                  operator:
+                    description: |-
+                      Operator is the verbosity of the operator, it is changed without restarting the operator. Defaults to the level
+                      the operator was started with
+                    format: int32
+                    minimum: 0
+                    type: integer
+                type: object
+              priorityClassName:
+                description: PriorityClassName is the priority class of the pods managed
+                  by the operator
+                type: string
+              resources:
+                description: |-
+                  Resources are the compute resources of the containers of each component. Components without resources use the
+                  default requests.
+                properties:
+                  cleanup:
+                    description: Cleanup is the container of the storage pool cleanup
+                      Jobs
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  csiProvisioner:
+                    description: CSIProvisioner is the csi-provisioner container of
+                      the CSI DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  csiSnapshotter:
+                    description: CSISnapshotter is the csi-snapshotter container of
+                      the CSI DaemonSet, it has no default requests
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  livenessProbe:
+                    description: LivenessProbe is the liveness-probe container of
+                      the CSI DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  mounter:
+                    description: Mounter is the container of the storage pool mounter
+                      Deployments
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  nodeDriverRegistrar:
+                    description: NodeDriverRegistrar is the node-driver-registrar
+                      container of the CSI DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  provisioner:
+                    description: Provisioner is the hostpath provisioner container
+                      of the DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
                 type: object
+              storageClasses:
+                description: StorageClasses are a list of storage classes the operator
+                  creates for the storage pools
+                items:
+                  description: StorageClass defines a storage class the operator creates
+                    and manages for a storage pool.
+                  properties:
+                    allowVolumeExpansion:
+                      description: AllowVolumeExpansion shows whether the storage
+                        class allows volume expand
+                      type: boolean
+                    default:
+                      description: Default marks the storage class as the default
+                        storage class of the cluster
+                      type: boolean
+                    name:
+                      description: Name is the name of the storage class
+                      type: string
+                    reclaimPolicy:
+                      description: ReclaimPolicy is the reclaim policy of the volumes
+                        created by the storage class, defaults to Delete
+                      enum:
+                      - Delete
+                      - Retain
+                      type: string
+                    storagePool:
+                      description: StoragePool is the name of the storage pool the
+                        storage class creates volumes in
+                      type: string
+                    volumeBindingMode:
+                      description: VolumeBindingMode indicates how volumes are bound,
+                        defaults to WaitForFirstConsumer
+                      enum:
+                      - Immediate
+                      - WaitForFirstConsumer
+                      type: string
+                  required:
+                  - name
+                  - storagePool
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
               storagePools:
                 description: StoragePools are a list of storage pools
                 items:
                   description: StoragePool defines how and where hostpath provisioner
                     can use storage to create volumes.
                   properties:
+                    encryption:
+                      description: |-
+                        Encryption encrypts the devices of a storage pool with a block volume mode PVC template using LUKS. It cannot
+                        be added to or removed from an existing storage pool.
+                      properties:
+                        key:
+                          description: Key is the key in the Secret data that holds
+                            the encryption key, defaults to key
+                          type: string
+                        secretName:
+                          description: SecretName is the name of the Secret in the
+                            namespace of the operator that holds the encryption key
+                          type: string
+                      required:
+                      - secretName
+                      type: object
+                    fsType:
+                      description: |-
+                        FSType is the filesystem the devices of a storage pool with a block volume mode PVC template are formatted
+                        with, defaults to xfs. It cannot be changed for an existing storage pool.
+                      enum:
+                      - xfs
+                      - ext4
+                      type: string
+                    mkfsOptions:
+                      description: |-
+                        MkfsOptions are the extra arguments passed to mkfs when formatting the devices of a storage pool with a block
+                        volume mode PVC template. They only apply to devices that are not formatted yet.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    mountOptions:
+                      description: |-
+                        MountOptions are the options the devices of a storage pool with a block volume mode PVC template are mounted
+                        with, for instance prjquota, noatime or discard.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
                     name:
                       description: Name specifies an identifier that is used in the
                         storage class arguments to identify the source to use.
                       type: string
+                    nearlyFullThreshold:
+                      description: |-
+                        NearlyFullThreshold is the percentage of used capacity on a node at which the storage pool is reported as
+                        nearly full, defaults to 90
+                      format: int32
+                      maximum: 100
+                      minimum: 1
+                      type: integer
+                    nodeSelector:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        NodeSelector restricts the storage pool to the nodes that have each of the indicated key-value pairs as labels.
+                        The pool PVCs and mounter pods are only created on matching nodes, and the pool is only used on matching nodes.
+                        If not set the pool is available on all nodes the hostpath provisioner runs on.
+                      type: object
                     overlayClassName:
                       description: OverlayClassName is used to set the name of the
                         overlay storage class
@@ -174,7 +871,7 @@ spec:
                         resources:
                           description: |-
                             resources represents the minimum resources the volume should have.
-                            If RecoverVolumeExpansionFailure feature is enabled users are allowed to specify resource requirements
+                            Users are allowed to specify resource requirements
                             that are lower than previous value but must still be higher than capacity recorded in the
                             status field of the claim.
                             More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources
@@ -283,12 +980,55 @@ spec:
                       description: SnapshotProvider defines the snapshot type, currently
                         only reflink supported
                       type: string
+                    unmount:
+                      description: |-
+                        Unmount configures how the storage pool is unmounted from the nodes when the pool is removed, and optionally
+                        when its mounter pod terminates.
+                      properties:
+                        lazy:
+                          description: |-
+                            Lazy detaches the storage pool from the node once the timeout expires while it is still busy, instead of
+                            failing. The filesystem stays in use until the processes holding it release it.
+                          type: boolean
+                        onTermination:
+                          description: |-
+                            OnTermination unmounts the storage pool from the node when its mounter pod terminates, for instance when the
+                            node is drained. By default the mount is left in place and taken over by the next mounter pod.
+                          type: boolean
+                        timeout:
+                          description: Timeout is how long to retry unmounting a busy
+                            storage pool before giving up, defaults to 5m.
+                          type: string
+                      type: object
                   required:
                   - name
                   - path
                   type: object
                 type: array
                 x-kubernetes-list-type: atomic
+              strandedVolumeGracePeriod:
+                description: |-
+                  StrandedVolumeGracePeriod is how long a persistent volume is pinned to a node that no longer exists before the
+                  stranded volume policy is applied, so the volumes of a node that is registered again are left alone. Defaults
+                  to 10m.
+                type: string
+              strandedVolumePolicy:
+                description: |-
+                  StrandedVolumePolicy defines what happens to persistent volumes provisioned by the hostpath provisioner that are
+                  pinned to nodes that no longer exist. Defaults to Report.
+                enum:
+                - Report
+                - Release
+                - Delete
+                type: string
+              uninstallStrategy:
+                description: |-
+                  UninstallStrategy defines what happens when the HostPathProvisioner CR is deleted while persistent volumes
+                  provisioned by the hostpath provisioner exist. Defaults to RemoveWorkloads.
+                enum:
+                - RemoveWorkloads
+                - BlockUninstallIfPVsExist
+                type: string
               workload:
                 description: Restrict on which nodes HPP workload pods will be scheduled
                 properties:
@@ -1248,9 +1988,10 @@ spec:
                         operator:
                           description: |-
                             Operator represents a key's relationship to the value.
-                            Valid operators are Exists and Equal. Defaults to Equal.
+                            Valid operators are Exists, Equal, Lt, and Gt. Defaults to Equal.
                             Exists is equivalent to wildcard for value, so that a pod can
                             tolerate all taints of a particular category.
+                            Lt and Gt perform numeric comparisons (requires feature gate TaintTolerationComparisonOperators).
                           type: string
                         tolerationSeconds:
                           description: |-
@@ -1272,6 +2013,31 @@ spec:
           status:
             description: HostPathProvisionerStatus defines the observed state of HostPathProvisioner
             properties:
+              cleanupFailures:
+                description: |-
+                  CleanupFailures are the storage pools that failed to clean up on a node, they may still be mounted there. A
+                  failure is kept after its cleanup Job is deleted, until the node is gone or skips the cleanup, or a later cleanup
+                  of the storage pool on the node succeeds.
+                items:
+                  description: CleanupFailure is a storage pool cleanup Job that failed
+                    on a node
+                  properties:
+                    message:
+                      description: Message is the reason the cleanup Job failed
+                      type: string
+                    nodeName:
+                      description: NodeName is the name of the node
+                      type: string
+                    storagePool:
+                      description: StoragePool is the name of the storage pool
+                      type: string
+                  required:
+                  - message
+                  - nodeName
+                  - storagePool
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
               conditions:
                 description: Conditions contains the current conditions observed by
                   the operator
@@ -1302,6 +2068,38 @@ spec:
                   type: object
                 type: array
                 x-kubernetes-list-type: atomic
+              images:
+                description: Images are the images the deployed components run
+                properties:
+                  csiProvisioner:
+                    description: CSIProvisioner is the image of the csi-provisioner
+                      container
+                    type: string
+                  csiSnapshotter:
+                    description: CSISnapshotter is the image of the csi-snapshotter
+                      container
+                    type: string
+                  legacyProvisioner:
+                    description: LegacyProvisioner is the image of the legacy hostpath
+                      provisioner container
+                    type: string
+                  livenessProbe:
+                    description: LivenessProbe is the image of the liveness-probe
+                      container
+                    type: string
+                  mounter:
+                    description: Mounter is the image of the storage pool mounter
+                      and cleanup containers
+                    type: string
+                  nodeDriverRegistrar:
+                    description: NodeDriverRegistrar is the image of the node-driver-registrar
+                      container
+                    type: string
+                  provisioner:
+                    description: Provisioner is the image of the hostpath provisioner
+                      CSI driver container
+                    type: string
+                type: object
               observedVersion:
                 description: ObservedVersion The observed version of the HostPathProvisioner
                   deployment
@@ -1310,13 +2108,60 @@ spec:
                 description: OperatorVersion The version of the HostPathProvisioner
                   Operator
                 type: string
+              storageClassStatuses:
+                description: StorageClassStatuses contains the status of the storage
+                  classes managed by the operator
+                items:
+                  description: StorageClassStatus is the status of an operator managed
+                    storage class
+                  properties:
+                    message:
+                      description: Message explains why the storage class is not ready
+                      type: string
+                    name:
+                      description: Name is the name of the storage class
+                      type: string
+                    ready:
+                      description: Ready indicates the storage class exists and its
+                        storage pool is ready
+                      type: boolean
+                    storagePool:
+                      description: StoragePool is the name of the storage pool used
+                        by the storage class
+                      type: string
+                  required:
+                  - name
+                  - ready
+                  - storagePool
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
               storagePoolStatuses:
                 items:
                   description: StoragePoolStatus is the status of the named storage
                     pool
                   properties:
+                    allocatable:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Allocatable is the capacity that is still available
+                        for new volumes on all nodes
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    capacity:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Capacity is the total size of the storage pool on all nodes, as published by the CSI driver. The capacity of
+                        each node is reported in the NodeStoragePool of the node.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
                     claimStatuses:
-                      description: The status of all the claims.
+                      description: |-
+                        The status of all the claims. Deprecated, no longer set, the claim of each node is reported in the
+                        NodeStoragePool of the node.
                       items:
                         description: ClaimStatus defines the storage claim status
                           for each PVC in a storage pool
@@ -1379,8 +2224,7 @@ spec:
                                   controller that\nonly is responsible for resizing
                                   capacity of the volume, should ignore PVC updates
                                   that change other valid\nresources associated with
-                                  PVC.\n\nThis is an alpha field and requires enabling
-                                  RecoverVolumeExpansionFailure feature."
+                                  PVC."
                                 type: object
                                 x-kubernetes-map-type: granular
                               allocatedResources:
@@ -1415,8 +2259,7 @@ spec:
                                   For example - a controller that\nonly is responsible
                                   for resizing capacity of the volume, should ignore
                                   PVC updates that change other valid\nresources associated
-                                  with PVC.\n\nThis is an alpha field and requires
-                                  enabling RecoverVolumeExpansionFailure feature."
+                                  with PVC."
                                 type: object
                               capacity:
                                 additionalProperties:
@@ -1520,12 +2363,79 @@ spec:
                         type: object
                       type: array
                       x-kubernetes-list-type: atomic
+                    conditions:
+                      description: Conditions contains the conditions of the storage
+                        pool
+                      items:
+                        description: Condition contains details for one aspect of
+                          the current state of this API Resource.
+                        properties:
+                          lastTransitionTime:
+                            description: |-
+                              lastTransitionTime is the last time the condition transitioned from one status to another.
+                              This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                            format: date-time
+                            type: string
+                          message:
+                            description: |-
+                              message is a human readable message indicating details about the transition.
+                              This may be an empty string.
+                            maxLength: 32768
+                            type: string
+                          observedGeneration:
+                            description: |-
+                              observedGeneration represents the .metadata.generation that the condition was set based upon.
+                              For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                              with respect to the current state of the instance.
+                            format: int64
+                            minimum: 0
+                            type: integer
+                          reason:
+                            description: |-
+                              reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                              Producers of specific condition types may define expected values and meanings for this field,
+                              and whether the values are considered a guaranteed API.
+                              The value should be a CamelCase string.
+                              This field may not be empty.
+                            maxLength: 1024
+                            minLength: 1
+                            pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                            type: string
+                          status:
+                            description: status of the condition, one of True, False,
+                              Unknown.
+                            enum:
+                            - "True"
+                            - "False"
+                            - Unknown
+                            type: string
+                          type:
+                            description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                            maxLength: 316
+                            pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                            type: string
+                        required:
+                        - lastTransitionTime
+                        - message
+                        - reason
+                        - status
+                        - type
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     currentReady:
                       description: CurrentReady is the number of currently ready replicasets.
                       type: integer
                     desiredReady:
                       description: DesiredReady is the number of desired ready replicasets.
                       type: integer
+                    encrypted:
+                      description: Encrypted indicates the devices of the storage
+                        pool are encrypted
+                      type: boolean
+                    message:
+                      description: Message describes why the storage pool is not ready
+                      type: string
                     name:
                       description: Name is the name of the storage pool
                       type: string
@@ -1533,6 +2443,18 @@ spec:
                       description: StoragePoolPhase indicates which phase the storage
                         pool is in.
                       type: string
+                    reason:
+                      description: Reason is a machine readable reason the storage
+                        pool is not ready, taken from the first node it is not ready
+                        on
+                      type: string
+                    used:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Used is the capacity that is in use on all nodes
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
                   required:
                   - name
                   - phase
@@ -1546,7 +2468,2761 @@ spec:
             type: object
         type: object
     served: true
+    storage: false
+  - name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: HostPathProvisioner is the Schema for the hostpathprovisioners
+          API
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: HostPathProvisionerSpec defines the desired state of HostPathProvisioner
+            properties:
+              featureGates:
+                description: FeatureGates are a list of specific enabled feature gates
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: set
+              imagePullPolicy:
+                description: ImagePullPolicy is the container pull policy for the
+                  host path provisioner containers
+                type: string
+              imagePullSecrets:
+                description: ImagePullSecrets are the secrets used to pull the images
+                  of the pods managed by the operator
+                items:
+                  description: |-
+                    LocalObjectReference contains enough information to let you locate the
+                    referenced object inside the same namespace.
+                  properties:
+                    name:
+                      default: ""
+                      description: |-
+                        Name of the referent.
+                        This field is effectively required, but due to backwards compatibility is
+                        allowed to be empty. Instances of this type with an empty value here are
+                        almost certainly wrong.
+                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                      type: string
+                  type: object
+                  x-kubernetes-map-type: atomic
+                type: array
+                x-kubernetes-list-type: atomic
+              images:
+                description: |-
+                  Images override the images of the components, components without an image use the image configured in the
+                  operator
+                properties:
+                  csiProvisioner:
+                    description: CSIProvisioner is the image of the csi-provisioner
+                      container
+                    type: string
+                  csiSnapshotter:
+                    description: CSISnapshotter is the image of the csi-snapshotter
+                      container
+                    type: string
+                  legacyProvisioner:
+                    description: LegacyProvisioner is the image of the legacy hostpath
+                      provisioner container
+                    type: string
+                  livenessProbe:
+                    description: LivenessProbe is the image of the liveness-probe
+                      container
+                    type: string
+                  mounter:
+                    description: Mounter is the image of the storage pool mounter
+                      and cleanup containers
+                    type: string
+                  nodeDriverRegistrar:
+                    description: NodeDriverRegistrar is the image of the node-driver-registrar
+                      container
+                    type: string
+                  provisioner:
+                    description: Provisioner is the image of the hostpath provisioner
+                      CSI driver container
+                    type: string
+                type: object
+              logVerbosity:
+                description: LogVerbosity is the log verbosity of the operator and
+                  the components
+                properties:
+                  components:
+                    description: Components override the verbosity of each component
+                    properties:
+                      csiProvisioner:
+                        description: CSIProvisioner is the verbosity of the csi-provisioner
+                          container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      csiSnapshotter:
+                        description: CSISnapshotter is the verbosity of the csi-snapshotter
+                          container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      mounter:
+                        description: |-
+                          Mounter is the verbosity of the storage pool mounter and cleanup containers, they log at the info level if no
+                          verbosity is set in the CR
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      nodeDriverRegistrar:
+                        description: NodeDriverRegistrar is the verbosity of the node-driver-registrar
+                          container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                      provisioner:
+                        description: Provisioner is the verbosity of the hostpath
+                          provisioner CSI driver container
+                        format: int32
+                        minimum: 0
+                        type: integer
+                    type: object
+                  default:
+                    description: |-
+                      Default is the verbosity of all the components, defaults to the VERBOSITY environment variable of the operator
+                      or 3 if not set
+                    format: int32
+                    minimum: 0
+                    type: integer
+                  nodes:
+                    description: |-
+                      Nodes override the verbosity of the components on specific nodes, the CSI driver on those nodes runs in a
+                      separate DaemonSet
+                    items:
+                      description: NodeLogVerbosity defines the log verbosity of the
+                        components on a node
+                      properties:
+                        components:
+                          description: Components override the verbosity of each component
+                            on the node
+                          properties:
+                            csiProvisioner:
+                              description: CSIProvisioner is the verbosity of the
+                                csi-provisioner container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            csiSnapshotter:
+                              description: CSISnapshotter is the verbosity of the
+                                csi-snapshotter container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            mounter:
+                              description: |-
+                                Mounter is the verbosity of the storage pool mounter and cleanup containers, they log at the info level if no
+                                verbosity is set in the CR
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            nodeDriverRegistrar:
+                              description: NodeDriverRegistrar is the verbosity of
+                                the node-driver-registrar container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                            provisioner:
+                              description: Provisioner is the verbosity of the hostpath
+                                provisioner CSI driver container
+                              format: int32
+                              minimum: 0
+                              type: integer
+                          type: object
+                        default:
+                          description: Default is the verbosity of all the components
+                            on the node
+                          format: int32
+                          minimum: 0
+                          type: integer
+                        nodeName:
+                          description: NodeName is the name of the node
+                          type: string
+                      required:
+                      - nodeName
+                      type: object
+                    type: array
+                    x-kubernetes-list-map-keys:
+                    - nodeName
+                    x-kubernetes-list-type: map
+                  operator:
+                    description: |-
+                      Operator is the verbosity of the operator, it is changed without restarting the operator. Defaults to the level
+                      the operator was started with
+                    format: int32
+                    minimum: 0
+                    type: integer
+                type: object
+              pathConfig:
+                description: PathConfig describes the location and layout of PV storage
+                  on nodes. Deprecated
+                properties:
+                  path:
+                    description: Path The path the directories for the PVs are created
+                      under
+                    type: string
+                  useNamingPrefix:
+                    description: UseNamingPrefix Use the name of the PVC requesting
+                      the PV as part of the directory created
+                    type: boolean
+                type: object
+              priorityClassName:
+                description: PriorityClassName is the priority class of the pods managed
+                  by the operator
+                type: string
+              resources:
+                description: |-
+                  Resources are the compute resources of the containers of each component. Components without resources use the
+                  default requests.
+                properties:
+                  cleanup:
+                    description: Cleanup is the container of the storage pool cleanup
+                      Jobs
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  csiProvisioner:
+                    description: CSIProvisioner is the csi-provisioner container of
+                      the CSI DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  csiSnapshotter:
+                    description: CSISnapshotter is the csi-snapshotter container of
+                      the CSI DaemonSet, it has no default requests
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  livenessProbe:
+                    description: LivenessProbe is the liveness-probe container of
+                      the CSI DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  mounter:
+                    description: Mounter is the container of the storage pool mounter
+                      Deployments
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  nodeDriverRegistrar:
+                    description: NodeDriverRegistrar is the node-driver-registrar
+                      container of the CSI DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  provisioner:
+                    description: Provisioner is the hostpath provisioner container
+                      of the DaemonSet
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This field depends on the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                type: object
+              storageClasses:
+                description: StorageClasses are a list of storage classes the operator
+                  creates for the storage pools
+                items:
+                  description: StorageClass defines a storage class the operator creates
+                    and manages for a storage pool.
+                  properties:
+                    allowVolumeExpansion:
+                      description: AllowVolumeExpansion shows whether the storage
+                        class allows volume expand
+                      type: boolean
+                    default:
+                      description: Default marks the storage class as the default
+                        storage class of the cluster
+                      type: boolean
+                    name:
+                      description: Name is the name of the storage class
+                      type: string
+                    reclaimPolicy:
+                      description: ReclaimPolicy is the reclaim policy of the volumes
+                        created by the storage class, defaults to Delete
+                      enum:
+                      - Delete
+                      - Retain
+                      type: string
+                    storagePool:
+                      description: StoragePool is the name of the storage pool the
+                        storage class creates volumes in
+                      type: string
+                    volumeBindingMode:
+                      description: VolumeBindingMode indicates how volumes are bound,
+                        defaults to WaitForFirstConsumer
+                      enum:
+                      - Immediate
+                      - WaitForFirstConsumer
+                      type: string
+                  required:
+                  - name
+                  - storagePool
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              storagePools:
+                description: StoragePools are a list of storage pools
+                items:
+                  description: StoragePool defines how and where hostpath provisioner
+                    can use storage to create volumes.
+                  properties:
+                    encryption:
+                      description: |-
+                        Encryption encrypts the devices of a storage pool with a block volume mode PVC template using LUKS. It cannot
+                        be added to or removed from an existing storage pool.
+                      properties:
+                        key:
+                          description: Key is the key in the Secret data that holds
+                            the encryption key, defaults to key
+                          type: string
+                        secretName:
+                          description: SecretName is the name of the Secret in the
+                            namespace of the operator that holds the encryption key
+                          type: string
+                      required:
+                      - secretName
+                      type: object
+                    fsType:
+                      description: |-
+                        FSType is the filesystem the devices of a storage pool with a block volume mode PVC template are formatted
+                        with, defaults to xfs. It cannot be changed for an existing storage pool.
+                      enum:
+                      - xfs
+                      - ext4
+                      type: string
+                    mkfsOptions:
+                      description: |-
+                        MkfsOptions are the extra arguments passed to mkfs when formatting the devices of a storage pool with a block
+                        volume mode PVC template. They only apply to devices that are not formatted yet.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    mountOptions:
+                      description: |-
+                        MountOptions are the options the devices of a storage pool with a block volume mode PVC template are mounted
+                        with, for instance prjquota, noatime or discard.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    name:
+                      description: Name specifies an identifier that is used in the
+                        storage class arguments to identify the source to use.
+                      type: string
+                    nearlyFullThreshold:
+                      description: |-
+                        NearlyFullThreshold is the percentage of used capacity on a node at which the storage pool is reported as
+                        nearly full, defaults to 90
+                      format: int32
+                      maximum: 100
+                      minimum: 1
+                      type: integer
+                    nodeSelector:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        NodeSelector restricts the storage pool to the nodes that have each of the indicated key-value pairs as labels.
+                        The pool PVCs and mounter pods are only created on matching nodes, and the pool is only used on matching nodes.
+                        If not set the pool is available on all nodes the hostpath provisioner runs on.
+                      type: object
+                    overlayClassName:
+                      description: OverlayClassName is used to set the name of the
+                        overlay storage class
+                      type: string
+                    path:
+                      description: the path to use on the host, this is a required
+                        field
+                      type: string
+                    pvcTemplate:
+                      description: PVCTemplate is the template of the PVC to create
+                        as the source volume
+                      properties:
+                        accessModes:
+                          description: |-
+                            accessModes contains the desired access modes the volume should have.
+                            More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        dataSource:
+                          description: |-
+                            dataSource field can be used to specify either:
+                            * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                            * An existing PVC (PersistentVolumeClaim)
+                            If the provisioner or an external controller can support the specified data source,
+                            it will create a new volume based on the contents of the specified data source.
+                            When the AnyVolumeDataSource feature gate is enabled, dataSource contents will be copied to dataSourceRef,
+                            and dataSourceRef contents will be copied to dataSource when dataSourceRef.namespace is not specified.
+                            If the namespace is specified, then dataSourceRef will not be copied to dataSource.
+                          properties:
+                            apiGroup:
+                              description: |-
+                                APIGroup is the group for the resource being referenced.
+                                If APIGroup is not specified, the specified Kind must be in the core API group.
+                                For any other third-party types, APIGroup is required.
+                              type: string
+                            kind:
+                              description: Kind is the type of resource being referenced
+                              type: string
+                            name:
+                              description: Name is the name of resource being referenced
+                              type: string
+                          required:
+                          - kind
+                          - name
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        dataSourceRef:
+                          description: |-
+                            dataSourceRef specifies the object from which to populate the volume with data, if a non-empty
+                            volume is desired. This may be any object from a non-empty API group (non
+                            core object) or a PersistentVolumeClaim object.
+                            When this field is specified, volume binding will only succeed if the type of
+                            the specified object matches some installed volume populator or dynamic
+                            provisioner.
+                            This field will replace the functionality of the dataSource field and as such
+                            if both fields are non-empty, they must have the same value. For backwards
+                            compatibility, when namespace isn't specified in dataSourceRef,
+                            both fields (dataSource and dataSourceRef) will be set to the same
+                            value automatically if one of them is empty and the other is non-empty.
+                            When namespace is specified in dataSourceRef,
+                            dataSource isn't set to the same value and must be empty.
+                            There are three important differences between dataSource and dataSourceRef:
+                            * While dataSource only allows two specific types of objects, dataSourceRef
+                              allows any non-core object, as well as PersistentVolumeClaim objects.
+                            * While dataSource ignores disallowed values (dropping them), dataSourceRef
+                              preserves all values, and generates an error if a disallowed value is
+                              specified.
+                            * While dataSource only allows local objects, dataSourceRef allows objects
+                              in any namespaces.
+                            (Beta) Using this field requires the AnyVolumeDataSource feature gate to be enabled.
+                            (Alpha) Using the namespace field of dataSourceRef requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                          properties:
+                            apiGroup:
+                              description: |-
+                                APIGroup is the group for the resource being referenced.
+                                If APIGroup is not specified, the specified Kind must be in the core API group.
+                                For any other third-party types, APIGroup is required.
+                              type: string
+                            kind:
+                              description: Kind is the type of resource being referenced
+                              type: string
+                            name:
+                              description: Name is the name of resource being referenced
+                              type: string
+                            namespace:
+                              description: |-
+                                Namespace is the namespace of resource being referenced
+                                Note that when a namespace is specified, a gateway.networking.k8s.io/ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.
+                                (Alpha) This field requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                              type: string
+                          required:
+                          - kind
+                          - name
+                          type: object
+                        resources:
+                          description: |-
+                            resources represents the minimum resources the volume should have.
+                            Users are allowed to specify resource requirements
+                            that are lower than previous value but must still be higher than capacity recorded in the
+                            status field of the claim.
+                            More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources
+                          properties:
+                            limits:
+                              additionalProperties:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              description: |-
+                                Limits describes the maximum amount of compute resources allowed.
+                                More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                              type: object
+                            requests:
+                              additionalProperties:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              description: |-
+                                Requests describes the minimum amount of compute resources required.
+                                If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                                otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                                More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                              type: object
+                          type: object
+                        selector:
+                          description: selector is a label query over volumes to consider
+                            for binding.
+                          properties:
+                            matchExpressions:
+                              description: matchExpressions is a list of label selector
+                                requirements. The requirements are ANDed.
+                              items:
+                                description: |-
+                                  A label selector requirement is a selector that contains values, a key, and an operator that
+                                  relates the key and values.
+                                properties:
+                                  key:
+                                    description: key is the label key that the selector
+                                      applies to.
+                                    type: string
+                                  operator:
+                                    description: |-
+                                      operator represents a key's relationship to a set of values.
+                                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                                    type: string
+                                  values:
+                                    description: |-
+                                      values is an array of string values. If the operator is In or NotIn,
+                                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                      the values array must be empty. This array is replaced during a strategic
+                                      merge patch.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                required:
+                                - key
+                                - operator
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            matchLabels:
+                              additionalProperties:
+                                type: string
+                              description: |-
+                                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                operator is "In", and the values array contains only "value". The requirements are ANDed.
+                              type: object
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        storageClassName:
+                          description: |-
+                            storageClassName is the name of the StorageClass required by the claim.
+                            More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1
+                          type: string
+                        volumeAttributesClassName:
+                          description: |-
+                            volumeAttributesClassName may be used to set the VolumeAttributesClass used by this claim.
+                            If specified, the CSI driver will create or update the volume with the attributes defined
+                            in the corresponding VolumeAttributesClass. This has a different purpose than storageClassName,
+                            it can be changed after the claim is created. An empty string or nil value indicates that no
+                            VolumeAttributesClass will be applied to the claim. If the claim enters an Infeasible error state,
+                            this field can be reset to its previous value (including nil) to cancel the modification.
+                            If the resource referred to by volumeAttributesClass does not exist, this PersistentVolumeClaim will be
+                            set to a Pending state, as reflected by the modifyVolumeStatus field, until such as a resource
+                            exists.
+                            More info: https://kubernetes.io/docs/concepts/storage/volume-attributes-classes/
+                          type: string
+                        volumeMode:
+                          description: |-
+                            volumeMode defines what type of volume is required by the claim.
+                            Value of Filesystem is implied when not included in claim spec.
+                          type: string
+                        volumeName:
+                          description: volumeName is the binding reference to the
+                            PersistentVolume backing this claim.
+                          type: string
+                      type: object
+                    snapshotProvider:
+                      description: SnapshotProvider defines the snapshot type, currently
+                        only reflink supported
+                      type: string
+                    unmount:
+                      description: |-
+                        Unmount configures how the storage pool is unmounted from the nodes when the pool is removed, and optionally
+                        when its mounter pod terminates.
+                      properties:
+                        lazy:
+                          description: |-
+                            Lazy detaches the storage pool from the node once the timeout expires while it is still busy, instead of
+                            failing. The filesystem stays in use until the processes holding it release it.
+                          type: boolean
+                        onTermination:
+                          description: |-
+                            OnTermination unmounts the storage pool from the node when its mounter pod terminates, for instance when the
+                            node is drained. By default the mount is left in place and taken over by the next mounter pod.
+                          type: boolean
+                        timeout:
+                          description: Timeout is how long to retry unmounting a busy
+                            storage pool before giving up, defaults to 5m.
+                          type: string
+                      type: object
+                  required:
+                  - name
+                  - path
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              strandedVolumeGracePeriod:
+                description: |-
+                  StrandedVolumeGracePeriod is how long a persistent volume is pinned to a node that no longer exists before the
+                  stranded volume policy is applied, so the volumes of a node that is registered again are left alone. Defaults
+                  to 10m.
+                type: string
+              strandedVolumePolicy:
+                description: |-
+                  StrandedVolumePolicy defines what happens to persistent volumes provisioned by the hostpath provisioner that are
+                  pinned to nodes that no longer exist. Defaults to Report.
+                enum:
+                - Report
+                - Release
+                - Delete
+                type: string
+              uninstallStrategy:
+                description: |-
+                  UninstallStrategy defines what happens when the HostPathProvisioner CR is deleted while persistent volumes
+                  provisioned by the hostpath provisioner exist. Defaults to RemoveWorkloads.
+                enum:
+                - RemoveWorkloads
+                - BlockUninstallIfPVsExist
+                type: string
+              workload:
+                description: Restrict on which nodes HPP workload pods will be scheduled
+                properties:
+                  affinity:
+                    description: |-
+                      affinity enables pod affinity/anti-affinity placement expanding the types of constraints
+                      that can be expressed with nodeSelector.
+                      affinity is going to be applied to the relevant kind of pods in parallel with nodeSelector
+                      See https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#affinity-and-anti-affinity
+                    properties:
+                      nodeAffinity:
+                        description: Describes node affinity scheduling rules for
+                          the pod.
+                        properties:
+                          preferredDuringSchedulingIgnoredDuringExecution:
+                            description: |-
+                              The scheduler will prefer to schedule pods to nodes that satisfy
+                              the affinity expressions specified by this field, but it may choose
+                              a node that violates one or more of the expressions. The node that is
+                              most preferred is the one with the greatest sum of weights, i.e.
+                              for each node that meets all of the scheduling requirements (resource
+                              request, requiredDuringScheduling affinity expressions, etc.),
+                              compute a sum by iterating through the elements of this field and adding
+                              "weight" to the sum if the node matches the corresponding matchExpressions; the
+                              node(s) with the highest sum are the most preferred.
+                            items:
+                              description: |-
+                                An empty preferred scheduling term matches all objects with implicit weight 0
+                                (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                              properties:
+                                preference:
+                                  description: A node selector term, associated with
+                                    the corresponding weight.
+                                  properties:
+                                    matchExpressions:
+                                      description: A list of node selector requirements
+                                        by node's labels.
+                                      items:
+                                        description: |-
+                                          A node selector requirement is a selector that contains values, a key, and an operator
+                                          that relates the key and values.
+                                        properties:
+                                          key:
+                                            description: The label key that the selector
+                                              applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              Represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              An array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. If the operator is Gt or Lt, the values
+                                              array must have a single element, which will be interpreted as an integer.
+                                              This array is replaced during a strategic merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    matchFields:
+                                      description: A list of node selector requirements
+                                        by node's fields.
+                                      items:
+                                        description: |-
+                                          A node selector requirement is a selector that contains values, a key, and an operator
+                                          that relates the key and values.
+                                        properties:
+                                          key:
+                                            description: The label key that the selector
+                                              applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              Represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              An array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. If the operator is Gt or Lt, the values
+                                              array must have a single element, which will be interpreted as an integer.
+                                              This array is replaced during a strategic merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                weight:
+                                  description: Weight associated with matching the
+                                    corresponding nodeSelectorTerm, in the range 1-100.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - preference
+                              - weight
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          requiredDuringSchedulingIgnoredDuringExecution:
+                            description: |-
+                              If the affinity requirements specified by this field are not met at
+                              scheduling time, the pod will not be scheduled onto the node.
+                              If the affinity requirements specified by this field cease to be met
+                              at some point during pod execution (e.g. due to an update), the system
+                              may or may not try to eventually evict the pod from its node.
+                            properties:
+                              nodeSelectorTerms:
+                                description: Required. A list of node selector terms.
+                                  The terms are ORed.
+                                items:
+                                  description: |-
+                                    A null or empty node selector term matches no objects. The requirements of
+                                    them are ANDed.
+                                    The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                                  properties:
+                                    matchExpressions:
+                                      description: A list of node selector requirements
+                                        by node's labels.
+                                      items:
+                                        description: |-
+                                          A node selector requirement is a selector that contains values, a key, and an operator
+                                          that relates the key and values.
+                                        properties:
+                                          key:
+                                            description: The label key that the selector
+                                              applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              Represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              An array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. If the operator is Gt or Lt, the values
+                                              array must have a single element, which will be interpreted as an integer.
+                                              This array is replaced during a strategic merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    matchFields:
+                                      description: A list of node selector requirements
+                                        by node's fields.
+                                      items:
+                                        description: |-
+                                          A node selector requirement is a selector that contains values, a key, and an operator
+                                          that relates the key and values.
+                                        properties:
+                                          key:
+                                            description: The label key that the selector
+                                              applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              Represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              An array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. If the operator is Gt or Lt, the values
+                                              array must have a single element, which will be interpreted as an integer.
+                                              This array is replaced during a strategic merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            required:
+                            - nodeSelectorTerms
+                            type: object
+                            x-kubernetes-map-type: atomic
+                        type: object
+                      podAffinity:
+                        description: Describes pod affinity scheduling rules (e.g.
+                          co-locate this pod in the same node, zone, etc. as some
+                          other pod(s)).
+                        properties:
+                          preferredDuringSchedulingIgnoredDuringExecution:
+                            description: |-
+                              The scheduler will prefer to schedule pods to nodes that satisfy
+                              the affinity expressions specified by this field, but it may choose
+                              a node that violates one or more of the expressions. The node that is
+                              most preferred is the one with the greatest sum of weights, i.e.
+                              for each node that meets all of the scheduling requirements (resource
+                              request, requiredDuringScheduling affinity expressions, etc.),
+                              compute a sum by iterating through the elements of this field and adding
+                              "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                              node(s) with the highest sum are the most preferred.
+                            items:
+                              description: The weights of all of the matched WeightedPodAffinityTerm
+                                fields are added per-node to find the most preferred
+                                node(s)
+                              properties:
+                                podAffinityTerm:
+                                  description: Required. A pod affinity term, associated
+                                    with the corresponding weight.
+                                  properties:
+                                    labelSelector:
+                                      description: |-
+                                        A label query over a set of resources, in this case pods.
+                                        If it's null, this PodAffinityTerm matches with no Pods.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    matchLabelKeys:
+                                      description: |-
+                                        MatchLabelKeys is a set of pod label keys to select which pods will
+                                        be taken into consideration. The keys are used to lookup values from the
+                                        incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                        to select the group of existing pods which pods will be taken into consideration
+                                        for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                        pod labels will be ignored. The default value is empty.
+                                        The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                        Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    mismatchLabelKeys:
+                                      description: |-
+                                        MismatchLabelKeys is a set of pod label keys to select which pods will
+                                        be taken into consideration. The keys are used to lookup values from the
+                                        incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                        to select the group of existing pods which pods will be taken into consideration
+                                        for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                        pod labels will be ignored. The default value is empty.
+                                        The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                        Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    namespaceSelector:
+                                      description: |-
+                                        A label query over the set of namespaces that the term applies to.
+                                        The term is applied to the union of the namespaces selected by this field
+                                        and the ones listed in the namespaces field.
+                                        null selector and null or empty namespaces list means "this pod's namespace".
+                                        An empty selector ({}) matches all namespaces.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    namespaces:
+                                      description: |-
+                                        namespaces specifies a static list of namespace names that the term applies to.
+                                        The term is applied to the union of the namespaces listed in this field
+                                        and the ones selected by namespaceSelector.
+                                        null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    topologyKey:
+                                      description: |-
+                                        This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                        the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                        whose value of the label with key topologyKey matches that of any node on which any of the
+                                        selected pods is running.
+                                        Empty topologyKey is not allowed.
+                                      type: string
+                                  required:
+                                  - topologyKey
+                                  type: object
+                                weight:
+                                  description: |-
+                                    weight associated with matching the corresponding podAffinityTerm,
+                                    in the range 1-100.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - podAffinityTerm
+                              - weight
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          requiredDuringSchedulingIgnoredDuringExecution:
+                            description: |-
+                              If the affinity requirements specified by this field are not met at
+                              scheduling time, the pod will not be scheduled onto the node.
+                              If the affinity requirements specified by this field cease to be met
+                              at some point during pod execution (e.g. due to a pod label update), the
+                              system may or may not try to eventually evict the pod from its node.
+                              When there are multiple elements, the lists of nodes corresponding to each
+                              podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                            items:
+                              description: |-
+                                Defines a set of pods (namely those matching the labelSelector
+                                relative to the given namespace(s)) that this pod should be
+                                co-located (affinity) or not co-located (anti-affinity) with,
+                                where co-located is defined as running on a node whose value of
+                                the label with key <topologyKey> matches that of any node on which
+                                a pod of the set of pods is running
+                              properties:
+                                labelSelector:
+                                  description: |-
+                                    A label query over a set of resources, in this case pods.
+                                    If it's null, this PodAffinityTerm matches with no Pods.
+                                  properties:
+                                    matchExpressions:
+                                      description: matchExpressions is a list of label
+                                        selector requirements. The requirements are
+                                        ANDed.
+                                      items:
+                                        description: |-
+                                          A label selector requirement is a selector that contains values, a key, and an operator that
+                                          relates the key and values.
+                                        properties:
+                                          key:
+                                            description: key is the label key that
+                                              the selector applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              operator represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              values is an array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. This array is replaced during a strategic
+                                              merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    matchLabels:
+                                      additionalProperties:
+                                        type: string
+                                      description: |-
+                                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                      type: object
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                matchLabelKeys:
+                                  description: |-
+                                    MatchLabelKeys is a set of pod label keys to select which pods will
+                                    be taken into consideration. The keys are used to lookup values from the
+                                    incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                    to select the group of existing pods which pods will be taken into consideration
+                                    for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                    pod labels will be ignored. The default value is empty.
+                                    The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                    Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                mismatchLabelKeys:
+                                  description: |-
+                                    MismatchLabelKeys is a set of pod label keys to select which pods will
+                                    be taken into consideration. The keys are used to lookup values from the
+                                    incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                    to select the group of existing pods which pods will be taken into consideration
+                                    for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                    pod labels will be ignored. The default value is empty.
+                                    The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                    Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                namespaceSelector:
+                                  description: |-
+                                    A label query over the set of namespaces that the term applies to.
+                                    The term is applied to the union of the namespaces selected by this field
+                                    and the ones listed in the namespaces field.
+                                    null selector and null or empty namespaces list means "this pod's namespace".
+                                    An empty selector ({}) matches all namespaces.
+                                  properties:
+                                    matchExpressions:
+                                      description: matchExpressions is a list of label
+                                        selector requirements. The requirements are
+                                        ANDed.
+                                      items:
+                                        description: |-
+                                          A label selector requirement is a selector that contains values, a key, and an operator that
+                                          relates the key and values.
+                                        properties:
+                                          key:
+                                            description: key is the label key that
+                                              the selector applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              operator represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              values is an array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. This array is replaced during a strategic
+                                              merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    matchLabels:
+                                      additionalProperties:
+                                        type: string
+                                      description: |-
+                                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                      type: object
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                namespaces:
+                                  description: |-
+                                    namespaces specifies a static list of namespace names that the term applies to.
+                                    The term is applied to the union of the namespaces listed in this field
+                                    and the ones selected by namespaceSelector.
+                                    null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                topologyKey:
+                                  description: |-
+                                    This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                    the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                    whose value of the label with key topologyKey matches that of any node on which any of the
+                                    selected pods is running.
+                                    Empty topologyKey is not allowed.
+                                  type: string
+                              required:
+                              - topologyKey
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                      podAntiAffinity:
+                        description: Describes pod anti-affinity scheduling rules
+                          (e.g. avoid putting this pod in the same node, zone, etc.
+                          as some other pod(s)).
+                        properties:
+                          preferredDuringSchedulingIgnoredDuringExecution:
+                            description: |-
+                              The scheduler will prefer to schedule pods to nodes that satisfy
+                              the anti-affinity expressions specified by this field, but it may choose
+                              a node that violates one or more of the expressions. The node that is
+                              most preferred is the one with the greatest sum of weights, i.e.
+                              for each node that meets all of the scheduling requirements (resource
+                              request, requiredDuringScheduling anti-affinity expressions, etc.),
+                              compute a sum by iterating through the elements of this field and subtracting
+                              "weight" from the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                              node(s) with the highest sum are the most preferred.
+                            items:
+                              description: The weights of all of the matched WeightedPodAffinityTerm
+                                fields are added per-node to find the most preferred
+                                node(s)
+                              properties:
+                                podAffinityTerm:
+                                  description: Required. A pod affinity term, associated
+                                    with the corresponding weight.
+                                  properties:
+                                    labelSelector:
+                                      description: |-
+                                        A label query over a set of resources, in this case pods.
+                                        If it's null, this PodAffinityTerm matches with no Pods.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    matchLabelKeys:
+                                      description: |-
+                                        MatchLabelKeys is a set of pod label keys to select which pods will
+                                        be taken into consideration. The keys are used to lookup values from the
+                                        incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                        to select the group of existing pods which pods will be taken into consideration
+                                        for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                        pod labels will be ignored. The default value is empty.
+                                        The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                        Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    mismatchLabelKeys:
+                                      description: |-
+                                        MismatchLabelKeys is a set of pod label keys to select which pods will
+                                        be taken into consideration. The keys are used to lookup values from the
+                                        incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                        to select the group of existing pods which pods will be taken into consideration
+                                        for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                        pod labels will be ignored. The default value is empty.
+                                        The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                        Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    namespaceSelector:
+                                      description: |-
+                                        A label query over the set of namespaces that the term applies to.
+                                        The term is applied to the union of the namespaces selected by this field
+                                        and the ones listed in the namespaces field.
+                                        null selector and null or empty namespaces list means "this pod's namespace".
+                                        An empty selector ({}) matches all namespaces.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    namespaces:
+                                      description: |-
+                                        namespaces specifies a static list of namespace names that the term applies to.
+                                        The term is applied to the union of the namespaces listed in this field
+                                        and the ones selected by namespaceSelector.
+                                        null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    topologyKey:
+                                      description: |-
+                                        This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                        the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                        whose value of the label with key topologyKey matches that of any node on which any of the
+                                        selected pods is running.
+                                        Empty topologyKey is not allowed.
+                                      type: string
+                                  required:
+                                  - topologyKey
+                                  type: object
+                                weight:
+                                  description: |-
+                                    weight associated with matching the corresponding podAffinityTerm,
+                                    in the range 1-100.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - podAffinityTerm
+                              - weight
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          requiredDuringSchedulingIgnoredDuringExecution:
+                            description: |-
+                              If the anti-affinity requirements specified by this field are not met at
+                              scheduling time, the pod will not be scheduled onto the node.
+                              If the anti-affinity requirements specified by this field cease to be met
+                              at some point during pod execution (e.g. due to a pod label update), the
+                              system may or may not try to eventually evict the pod from its node.
+                              When there are multiple elements, the lists of nodes corresponding to each
+                              podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                            items:
+                              description: |-
+                                Defines a set of pods (namely those matching the labelSelector
+                                relative to the given namespace(s)) that this pod should be
+                                co-located (affinity) or not co-located (anti-affinity) with,
+                                where co-located is defined as running on a node whose value of
+                                the label with key <topologyKey> matches that of any node on which
+                                a pod of the set of pods is running
+                              properties:
+                                labelSelector:
+                                  description: |-
+                                    A label query over a set of resources, in this case pods.
+                                    If it's null, this PodAffinityTerm matches with no Pods.
+                                  properties:
+                                    matchExpressions:
+                                      description: matchExpressions is a list of label
+                                        selector requirements. The requirements are
+                                        ANDed.
+                                      items:
+                                        description: |-
+                                          A label selector requirement is a selector that contains values, a key, and an operator that
+                                          relates the key and values.
+                                        properties:
+                                          key:
+                                            description: key is the label key that
+                                              the selector applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              operator represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              values is an array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. This array is replaced during a strategic
+                                              merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    matchLabels:
+                                      additionalProperties:
+                                        type: string
+                                      description: |-
+                                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                      type: object
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                matchLabelKeys:
+                                  description: |-
+                                    MatchLabelKeys is a set of pod label keys to select which pods will
+                                    be taken into consideration. The keys are used to lookup values from the
+                                    incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                    to select the group of existing pods which pods will be taken into consideration
+                                    for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                    pod labels will be ignored. The default value is empty.
+                                    The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                    Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                mismatchLabelKeys:
+                                  description: |-
+                                    MismatchLabelKeys is a set of pod label keys to select which pods will
+                                    be taken into consideration. The keys are used to lookup values from the
+                                    incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                    to select the group of existing pods which pods will be taken into consideration
+                                    for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                    pod labels will be ignored. The default value is empty.
+                                    The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                    Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                namespaceSelector:
+                                  description: |-
+                                    A label query over the set of namespaces that the term applies to.
+                                    The term is applied to the union of the namespaces selected by this field
+                                    and the ones listed in the namespaces field.
+                                    null selector and null or empty namespaces list means "this pod's namespace".
+                                    An empty selector ({}) matches all namespaces.
+                                  properties:
+                                    matchExpressions:
+                                      description: matchExpressions is a list of label
+                                        selector requirements. The requirements are
+                                        ANDed.
+                                      items:
+                                        description: |-
+                                          A label selector requirement is a selector that contains values, a key, and an operator that
+                                          relates the key and values.
+                                        properties:
+                                          key:
+                                            description: key is the label key that
+                                              the selector applies to.
+                                            type: string
+                                          operator:
+                                            description: |-
+                                              operator represents a key's relationship to a set of values.
+                                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                                            type: string
+                                          values:
+                                            description: |-
+                                              values is an array of string values. If the operator is In or NotIn,
+                                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                              the values array must be empty. This array is replaced during a strategic
+                                              merge patch.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        required:
+                                        - key
+                                        - operator
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    matchLabels:
+                                      additionalProperties:
+                                        type: string
+                                      description: |-
+                                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                      type: object
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                namespaces:
+                                  description: |-
+                                    namespaces specifies a static list of namespace names that the term applies to.
+                                    The term is applied to the union of the namespaces listed in this field
+                                    and the ones selected by namespaceSelector.
+                                    null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                topologyKey:
+                                  description: |-
+                                    This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                    the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                    whose value of the label with key topologyKey matches that of any node on which any of the
+                                    selected pods is running.
+                                    Empty topologyKey is not allowed.
+                                  type: string
+                              required:
+                              - topologyKey
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                    type: object
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      nodeSelector is the node selector applied to the relevant kind of pods
+                      It specifies a map of key-value pairs: for the pod to be eligible to run on a node,
+                      the node must have each of the indicated key-value pairs as labels
+                      (it can have additional labels as well).
+                      See https://kubernetes.io/docs/concepts/configuration/assign-pod-node/#nodeselector
+                    type: object
+                  tolerations:
+                    description: |-
+                      tolerations is a list of tolerations applied to the relevant kind of pods
+                      See https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/ for more info.
+                      These are additional tolerations other than default ones.
+                    items:
+                      description: |-
+                        The pod this Toleration is attached to tolerates any taint that matches
+                        the triple <key,value,effect> using the matching operator <operator>.
+                      properties:
+                        effect:
+                          description: |-
+                            Effect indicates the taint effect to match. Empty means match all taint effects.
+                            When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                          type: string
+                        key:
+                          description: |-
+                            Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                            If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                          type: string
+                        operator:
+                          description: |-
+                            Operator represents a key's relationship to the value.
+                            Valid operators are Exists, Equal, Lt, and Gt. Defaults to Equal.
+                            Exists is equivalent to wildcard for value, so that a pod can
+                            tolerate all taints of a particular category.
+                            Lt and Gt perform numeric comparisons (requires feature gate TaintTolerationComparisonOperators).
+                          type: string
+                        tolerationSeconds:
+                          description: |-
+                            TolerationSeconds represents the period of time the toleration (which must be
+                            of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                            it is not set, which means tolerate the taint forever (do not evict). Zero and
+                            negative values will be treated as 0 (evict immediately) by the system.
+                          format: int64
+                          type: integer
+                        value:
+                          description: |-
+                            Value is the taint value the toleration matches to.
+                            If the operator is Exists, the value should be empty, otherwise just a regular string.
+                          type: string
+                      type: object
+                    type: array
+                type: object
+            type: object
+          status:
+            description: HostPathProvisionerStatus defines the observed state of HostPathProvisioner
+            properties:
+              cleanupFailures:
+                description: |-
+                  CleanupFailures are the storage pools that failed to clean up on a node, they may still be mounted there. A
+                  failure is kept after its cleanup Job is deleted, until the node is gone or skips the cleanup, or a later cleanup
+                  of the storage pool on the node succeeds.
+                items:
+                  description: CleanupFailure is a storage pool cleanup Job that failed
+                    on a node
+                  properties:
+                    message:
+                      description: Message is the reason the cleanup Job failed
+                      type: string
+                    nodeName:
+                      description: NodeName is the name of the node
+                      type: string
+                    storagePool:
+                      description: StoragePool is the name of the storage pool
+                      type: string
+                  required:
+                  - message
+                  - nodeName
+                  - storagePool
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              conditions:
+                description: Conditions contains the current conditions observed by
+                  the operator
+                items:
+                  description: |-
+                    Condition represents the state of the operator's
+                    reconciliation functionality.
+                  properties:
+                    lastHeartbeatTime:
+                      format: date-time
+                      type: string
+                    lastTransitionTime:
+                      format: date-time
+                      type: string
+                    message:
+                      type: string
+                    reason:
+                      type: string
+                    status:
+                      type: string
+                    type:
+                      description: ConditionType is the state of the operator's reconciliation
+                        functionality.
+                      type: string
+                  required:
+                  - status
+                  - type
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              images:
+                description: Images are the images the deployed components run
+                properties:
+                  csiProvisioner:
+                    description: CSIProvisioner is the image of the csi-provisioner
+                      container
+                    type: string
+                  csiSnapshotter:
+                    description: CSISnapshotter is the image of the csi-snapshotter
+                      container
+                    type: string
+                  legacyProvisioner:
+                    description: LegacyProvisioner is the image of the legacy hostpath
+                      provisioner container
+                    type: string
+                  livenessProbe:
+                    description: LivenessProbe is the image of the liveness-probe
+                      container
+                    type: string
+                  mounter:
+                    description: Mounter is the image of the storage pool mounter
+                      and cleanup containers
+                    type: string
+                  nodeDriverRegistrar:
+                    description: NodeDriverRegistrar is the image of the node-driver-registrar
+                      container
+                    type: string
+                  provisioner:
+                    description: Provisioner is the image of the hostpath provisioner
+                      CSI driver container
+                    type: string
+                type: object
+              observedVersion:
+                description: ObservedVersion The observed version of the HostPathProvisioner
+                  deployment
+                type: string
+              operatorVersion:
+                description: OperatorVersion The version of the HostPathProvisioner
+                  Operator
+                type: string
+              storageClassStatuses:
+                description: StorageClassStatuses contains the status of the storage
+                  classes managed by the operator
+                items:
+                  description: StorageClassStatus is the status of an operator managed
+                    storage class
+                  properties:
+                    message:
+                      description: Message explains why the storage class is not ready
+                      type: string
+                    name:
+                      description: Name is the name of the storage class
+                      type: string
+                    ready:
+                      description: Ready indicates the storage class exists and its
+                        storage pool is ready
+                      type: boolean
+                    storagePool:
+                      description: StoragePool is the name of the storage pool used
+                        by the storage class
+                      type: string
+                  required:
+                  - name
+                  - ready
+                  - storagePool
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              storagePoolStatuses:
+                items:
+                  description: StoragePoolStatus is the status of the named storage
+                    pool
+                  properties:
+                    allocatable:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Allocatable is the capacity that is still available
+                        for new volumes on all nodes
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    capacity:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Capacity is the total size of the storage pool on all nodes, as published by the CSI driver. The capacity of
+                        each node is reported in the NodeStoragePool of the node.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    claimStatuses:
+                      description: |-
+                        The status of all the claims. Deprecated, no longer set, the claim of each node is reported in the
+                        NodeStoragePool of the node.
+                      items:
+                        description: ClaimStatus defines the storage claim status
+                          for each PVC in a storage pool
+                        properties:
+                          name:
+                            description: Name of the PersistentVolumeClaim
+                            type: string
+                          status:
+                            description: Status of the PersistentVolumeClaim
+                            properties:
+                              accessModes:
+                                description: |-
+                                  accessModes contains the actual access modes the volume backing the PVC has.
+                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              allocatedResourceStatuses:
+                                additionalProperties:
+                                  description: |-
+                                    When a controller receives persistentvolume claim update with ClaimResourceStatus for a resource
+                                    that it does not recognizes, then it should ignore that update and let other controllers
+                                    handle it.
+                                  type: string
+                                description: "allocatedResourceStatuses stores status
+                                  of resource being resized for the given PVC.\nKey
+                                  names follow standard Kubernetes label syntax. Valid
+                                  values are either:\n\t* Un-prefixed keys:\n\t\t-
+                                  storage - the capacity of the volume.\n\t* Custom
+                                  resources must use implementation-defined prefixed
+                                  names such as \"example.com/my-custom-resource\"\nApart
+                                  from above values - keys that are unprefixed or
+                                  have kubernetes.io prefix are considered\nreserved
+                                  and hence may not be used.\n\nClaimResourceStatus
+                                  can be in any of following states:\n\t- ControllerResizeInProgress:\n\t\tState
+                                  set when resize controller starts resizing the volume
+                                  in control-plane.\n\t- ControllerResizeFailed:\n\t\tState
+                                  set when resize has failed in resize controller
+                                  with a terminal error.\n\t- NodeResizePending:\n\t\tState
+                                  set when resize controller has finished resizing
+                                  the volume but further resizing of\n\t\tvolume is
+                                  needed on the node.\n\t- NodeResizeInProgress:\n\t\tState
+                                  set when kubelet starts resizing the volume.\n\t-
+                                  NodeResizeFailed:\n\t\tState set when resizing has
+                                  failed in kubelet with a terminal error. Transient
+                                  errors don't set\n\t\tNodeResizeFailed.\nFor example:
+                                  if expanding a PVC for more capacity - this field
+                                  can be one of the following states:\n\t- pvc.status.allocatedResourceStatus['storage']
+                                  = \"ControllerResizeInProgress\"\n     - pvc.status.allocatedResourceStatus['storage']
+                                  = \"ControllerResizeFailed\"\n     - pvc.status.allocatedResourceStatus['storage']
+                                  = \"NodeResizePending\"\n     - pvc.status.allocatedResourceStatus['storage']
+                                  = \"NodeResizeInProgress\"\n     - pvc.status.allocatedResourceStatus['storage']
+                                  = \"NodeResizeFailed\"\nWhen this field is not set,
+                                  it means that no resize operation is in progress
+                                  for the given PVC.\n\nA controller that receives
+                                  PVC update with previously unknown resourceName
+                                  or ClaimResourceStatus\nshould ignore the update
+                                  for the purpose it was designed. For example - a
+                                  controller that\nonly is responsible for resizing
+                                  capacity of the volume, should ignore PVC updates
+                                  that change other valid\nresources associated with
+                                  PVC."
+                                type: object
+                                x-kubernetes-map-type: granular
+                              allocatedResources:
+                                additionalProperties:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                description: "allocatedResources tracks the resources
+                                  allocated to a PVC including its capacity.\nKey
+                                  names follow standard Kubernetes label syntax. Valid
+                                  values are either:\n\t* Un-prefixed keys:\n\t\t-
+                                  storage - the capacity of the volume.\n\t* Custom
+                                  resources must use implementation-defined prefixed
+                                  names such as \"example.com/my-custom-resource\"\nApart
+                                  from above values - keys that are unprefixed or
+                                  have kubernetes.io prefix are considered\nreserved
+                                  and hence may not be used.\n\nCapacity reported
+                                  here may be larger than the actual capacity when
+                                  a volume expansion operation\nis requested.\nFor
+                                  storage quota, the larger value from allocatedResources
+                                  and PVC.spec.resources is used.\nIf allocatedResources
+                                  is not set, PVC.spec.resources alone is used for
+                                  quota calculation.\nIf a volume expansion capacity
+                                  request is lowered, allocatedResources is only\nlowered
+                                  if there are no expansion operations in progress
+                                  and if the actual volume capacity\nis equal or lower
+                                  than the requested capacity.\n\nA controller that
+                                  receives PVC update with previously unknown resourceName\nshould
+                                  ignore the update for the purpose it was designed.
+                                  For example - a controller that\nonly is responsible
+                                  for resizing capacity of the volume, should ignore
+                                  PVC updates that change other valid\nresources associated
+                                  with PVC."
+                                type: object
+                              capacity:
+                                additionalProperties:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                description: capacity represents the actual resources
+                                  of the underlying volume.
+                                type: object
+                              conditions:
+                                description: |-
+                                  conditions is the current Condition of persistent volume claim. If underlying persistent volume is being
+                                  resized then the Condition will be set to 'Resizing'.
+                                items:
+                                  description: PersistentVolumeClaimCondition contains
+                                    details about state of pvc
+                                  properties:
+                                    lastProbeTime:
+                                      description: lastProbeTime is the time we probed
+                                        the condition.
+                                      format: date-time
+                                      type: string
+                                    lastTransitionTime:
+                                      description: lastTransitionTime is the time
+                                        the condition transitioned from one status
+                                        to another.
+                                      format: date-time
+                                      type: string
+                                    message:
+                                      description: message is the human-readable message
+                                        indicating details about last transition.
+                                      type: string
+                                    reason:
+                                      description: |-
+                                        reason is a unique, this should be a short, machine understandable string that gives the reason
+                                        for condition's last transition. If it reports "Resizing" that means the underlying
+                                        persistent volume is being resized.
+                                      type: string
+                                    status:
+                                      description: |-
+                                        Status is the status of the condition.
+                                        Can be True, False, Unknown.
+                                        More info: https://kubernetes.io/docs/reference/kubernetes-api/config-and-storage-resources/persistent-volume-claim-v1/#:~:text=state%20of%20pvc-,conditions.status,-(string)%2C%20required
+                                      type: string
+                                    type:
+                                      description: |-
+                                        Type is the type of the condition.
+                                        More info: https://kubernetes.io/docs/reference/kubernetes-api/config-and-storage-resources/persistent-volume-claim-v1/#:~:text=set%20to%20%27ResizeStarted%27.-,PersistentVolumeClaimCondition,-contains%20details%20about
+                                      type: string
+                                  required:
+                                  - status
+                                  - type
+                                  type: object
+                                type: array
+                                x-kubernetes-list-map-keys:
+                                - type
+                                x-kubernetes-list-type: map
+                              currentVolumeAttributesClassName:
+                                description: |-
+                                  currentVolumeAttributesClassName is the current name of the VolumeAttributesClass the PVC is using.
+                                  When unset, there is no VolumeAttributeClass applied to this PersistentVolumeClaim
+                                type: string
+                              modifyVolumeStatus:
+                                description: |-
+                                  ModifyVolumeStatus represents the status object of ControllerModifyVolume operation.
+                                  When this is unset, there is no ModifyVolume operation being attempted.
+                                properties:
+                                  status:
+                                    description: "status is the status of the ControllerModifyVolume
+                                      operation. It can be in any of following states:\n
+                                      - Pending\n   Pending indicates that the PersistentVolumeClaim
+                                      cannot be modified due to unmet requirements,
+                                      such as\n   the specified VolumeAttributesClass
+                                      not existing.\n - InProgress\n   InProgress
+                                      indicates that the volume is being modified.\n
+                                      - Infeasible\n  Infeasible indicates that the
+                                      request has been rejected as invalid by the
+                                      CSI driver. To\n\t  resolve the error, a valid
+                                      VolumeAttributesClass needs to be specified.\nNote:
+                                      New statuses can be added in the future. Consumers
+                                      should check for unknown statuses and fail appropriately."
+                                    type: string
+                                  targetVolumeAttributesClassName:
+                                    description: targetVolumeAttributesClassName is
+                                      the name of the VolumeAttributesClass the PVC
+                                      currently being reconciled
+                                    type: string
+                                required:
+                                - status
+                                type: object
+                              phase:
+                                description: phase represents the current phase of
+                                  PersistentVolumeClaim.
+                                type: string
+                            type: object
+                        required:
+                        - name
+                        - status
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    conditions:
+                      description: Conditions contains the conditions of the storage
+                        pool
+                      items:
+                        description: Condition contains details for one aspect of
+                          the current state of this API Resource.
+                        properties:
+                          lastTransitionTime:
+                            description: |-
+                              lastTransitionTime is the last time the condition transitioned from one status to another.
+                              This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                            format: date-time
+                            type: string
+                          message:
+                            description: |-
+                              message is a human readable message indicating details about the transition.
+                              This may be an empty string.
+                            maxLength: 32768
+                            type: string
+                          observedGeneration:
+                            description: |-
+                              observedGeneration represents the .metadata.generation that the condition was set based upon.
+                              For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                              with respect to the current state of the instance.
+                            format: int64
+                            minimum: 0
+                            type: integer
+                          reason:
+                            description: |-
+                              reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                              Producers of specific condition types may define expected values and meanings for this field,
+                              and whether the values are considered a guaranteed API.
+                              The value should be a CamelCase string.
+                              This field may not be empty.
+                            maxLength: 1024
+                            minLength: 1
+                            pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                            type: string
+                          status:
+                            description: status of the condition, one of True, False,
+                              Unknown.
+                            enum:
+                            - "True"
+                            - "False"
+                            - Unknown
+                            type: string
+                          type:
+                            description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                            maxLength: 316
+                            pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                            type: string
+                        required:
+                        - lastTransitionTime
+                        - message
+                        - reason
+                        - status
+                        - type
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    currentReady:
+                      description: CurrentReady is the number of currently ready replicasets.
+                      type: integer
+                    desiredReady:
+                      description: DesiredReady is the number of desired ready replicasets.
+                      type: integer
+                    encrypted:
+                      description: Encrypted indicates the devices of the storage
+                        pool are encrypted
+                      type: boolean
+                    message:
+                      description: Message describes why the storage pool is not ready
+                      type: string
+                    name:
+                      description: Name is the name of the storage pool
+                      type: string
+                    phase:
+                      description: StoragePoolPhase indicates which phase the storage
+                        pool is in.
+                      type: string
+                    reason:
+                      description: Reason is a machine readable reason the storage
+                        pool is not ready, taken from the first node it is not ready
+                        on
+                      type: string
+                    used:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: Used is the capacity that is in use on all nodes
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - name
+                  - phase
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              targetVersion:
+                description: TargetVersion The targeted version of the HostPathProvisioner
+                  deployment
+                type: string
+            type: object
+        type: object
+    served: true
+    storage: true
+status:
+  acceptedNames:
+    kind: ""
+    plural: ""
+  conditions: null
+  storedVersions: null
+`
+
+//nodeStoragePoolCRD is a string yaml of the NodeStoragePool CRD
+var nodeStoragePoolCRD string = 
+`apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.0
+  name: nodestoragepools.hostpathprovisioner.kubevirt.io
+spec:
+  group: hostpathprovisioner.kubevirt.io
+  names:
+    kind: NodeStoragePool
+    listKind: NodeStoragePoolList
+    plural: nodestoragepools
+    shortNames:
+    - nsp
+    singular: nodestoragepool
+  scope: Cluster
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.nodeName
+      name: Node
+      type: string
+    - jsonPath: .spec.storagePoolName
+      name: Pool
+      type: string
+    - jsonPath: .status.phase
+      name: Phase
+      type: string
+    - jsonPath: .status.capacity
+      name: Capacity
+      type: string
+    - jsonPath: .status.used
+      name: Used
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1
+    schema:
+      openAPIV3Schema:
+        description: |-
+          NodeStoragePool reports the state of a storage pool on a single node. The operator creates one for each node and
+          storage pool, and overwrites any changes made to it.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: NodeStoragePoolSpec identifies the node and storage pool
+              of a NodeStoragePool
+            properties:
+              nodeName:
+                description: NodeName is the name of the node
+                type: string
+              storagePoolName:
+                description: StoragePoolName is the name of the storage pool
+                type: string
+            required:
+            - nodeName
+            - storagePoolName
+            type: object
+          status:
+            description: NodeStoragePoolStatus is the state of a storage pool on a
+              single node
+            properties:
+              allocatable:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Allocatable is the capacity that is still available for
+                  new volumes on the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              capacity:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Capacity is the total size of the storage pool on the
+                  node, as published by the CSI driver
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              claimCapacity:
+                anyOf:
+                - type: integer
+                - type: string
+                description: ClaimCapacity is the size of the PersistentVolumeClaim
+                  backing the storage pool on the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              claimName:
+                description: ClaimName is the name of the PersistentVolumeClaim backing
+                  the storage pool on the node
+                type: string
+              claimPhase:
+                description: ClaimPhase is the phase of the PersistentVolumeClaim
+                  backing the storage pool on the node
+                type: string
+              encrypted:
+                description: Encrypted indicates the device of the storage pool is
+                  encrypted and opened on the node
+                type: boolean
+              expansion:
+                description: |-
+                  Expansion indicates the state of the expansion of the PersistentVolumeClaim backing the storage pool on the
+                  node to the size of the PVC template. It is empty if the claim has the size of the template.
+                type: string
+              lastError:
+                description: LastError is the last error that kept the storage pool
+                  from becoming ready on the node
+                type: string
+              mounted:
+                description: |-
+                  Mounted indicates the storage pool is mounted on the node. Storage pools without a PVC template are always
+                  mounted.
+                type: boolean
+              mounterPod:
+                description: MounterPod is the name of the pod that mounts the storage
+                  pool on the node
+                type: string
+              phase:
+                description: Phase indicates which phase the storage pool is in on
+                  the node.
+                type: string
+              reason:
+                description: Reason is a machine readable reason the storage pool
+                  is not ready on the node
+                type: string
+              used:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Used is the capacity that is in use on the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - mounted
+            type: object
+        type: object
+    served: true
+    storage: false
+    subresources: {}
+  - additionalPrinterColumns:
+    - jsonPath: .spec.nodeName
+      name: Node
+      type: string
+    - jsonPath: .spec.storagePoolName
+      name: Pool
+      type: string
+    - jsonPath: .status.phase
+      name: Phase
+      type: string
+    - jsonPath: .status.capacity
+      name: Capacity
+      type: string
+    - jsonPath: .status.used
+      name: Used
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: |-
+          NodeStoragePool reports the state of a storage pool on a single node. The operator creates one for each node and
+          storage pool, and overwrites any changes made to it.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: NodeStoragePoolSpec identifies the node and storage pool
+              of a NodeStoragePool
+            properties:
+              nodeName:
+                description: NodeName is the name of the node
+                type: string
+              storagePoolName:
+                description: StoragePoolName is the name of the storage pool
+                type: string
+            required:
+            - nodeName
+            - storagePoolName
+            type: object
+          status:
+            description: NodeStoragePoolStatus is the state of a storage pool on a
+              single node
+            properties:
+              allocatable:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Allocatable is the capacity that is still available for
+                  new volumes on the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              capacity:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Capacity is the total size of the storage pool on the
+                  node, as published by the CSI driver
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              claimCapacity:
+                anyOf:
+                - type: integer
+                - type: string
+                description: ClaimCapacity is the size of the PersistentVolumeClaim
+                  backing the storage pool on the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              claimName:
+                description: ClaimName is the name of the PersistentVolumeClaim backing
+                  the storage pool on the node
+                type: string
+              claimPhase:
+                description: ClaimPhase is the phase of the PersistentVolumeClaim
+                  backing the storage pool on the node
+                type: string
+              encrypted:
+                description: Encrypted indicates the device of the storage pool is
+                  encrypted and opened on the node
+                type: boolean
+              expansion:
+                description: |-
+                  Expansion indicates the state of the expansion of the PersistentVolumeClaim backing the storage pool on the
+                  node to the size of the PVC template. It is empty if the claim has the size of the template.
+                type: string
+              lastError:
+                description: LastError is the last error that kept the storage pool
+                  from becoming ready on the node
+                type: string
+              mounted:
+                description: |-
+                  Mounted indicates the storage pool is mounted on the node. Storage pools without a PVC template are always
+                  mounted.
+                type: boolean
+              mounterPod:
+                description: MounterPod is the name of the pod that mounts the storage
+                  pool on the node
+                type: string
+              phase:
+                description: Phase indicates which phase the storage pool is in on
+                  the node.
+                type: string
+              reason:
+                description: Reason is a machine readable reason the storage pool
+                  is not ready on the node
+                type: string
+              used:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Used is the capacity that is in use on the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - mounted
+            type: object
+        type: object
+    served: true
     storage: true
+    subresources: {}
 status:
   acceptedNames:
     kind: ""
@@ -81,3 +81,10 @@ func CreateCRDDef() *extv1.CustomResourceDefinition {
 	_ = k8syaml.NewYAMLToJSONDecoder(strings.NewReader(hppCRD)).Decode(&crd)
 	return &crd
 }
+
+// CreateNodeStoragePoolCRDDef creates the node storage pool CRD definition.
+func CreateNodeStoragePoolCRDDef() *extv1.CustomResourceDefinition {
+	crd := extv1.CustomResourceDefinition{}
+	_ = k8syaml.NewYAMLToJSONDecoder(strings.NewReader(nodeStoragePoolCRD)).Decode(&crd)
+	return &crd
+}
@@ -16,14 +16,6 @@ rules:
   - get
   - watch
   - create
-- apiGroups:
-  - apps
-  resourceNames:
-  - hostpath-provisioner
-  - hostpath-provisioner-csi
-  resources:
-  - daemonsets
-  verbs:
   - delete
   - update
 - apiGroups:
@@ -34,6 +34,10 @@ func main() {
 		panic(err)
 	}
 
+	if err := metrics.SetupMounterMetrics(); err != nil {
+		panic(err)
+	}
+
 	if err := rules.SetupRules("test"); err != nil {
 		panic(err)
 	}